@@ -0,0 +1,269 @@
+package alphavantage
+
+import (
+	"bytes"
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Cache stores raw alphavantage responses
+type Cache interface {
+	// Get returns cached value, false if the key is missing or expired
+	Get(key string) ([]byte, bool)
+	// Set stores value for ttl
+	Set(key string, value []byte, ttl time.Duration) error
+}
+
+// DefaultCacheTTLs how long responses of every function are cached; functions not listed are not cached
+var DefaultCacheTTLs = map[string]time.Duration{
+	"OVERVIEW":         24 * time.Hour,
+	"ETF_PROFILE":      24 * time.Hour,
+	"BALANCE_SHEET":    7 * 24 * time.Hour,
+	"CASH_FLOW":        7 * 24 * time.Hour,
+	"INCOME_STATEMENT": 7 * 24 * time.Hour,
+	"EARNINGS":         24 * time.Hour,
+	"DIVIDENDS":        24 * time.Hour,
+	"SPLITS":           24 * time.Hour,
+
+	"INSIDER_TRANSACTIONS":     24 * time.Hour,
+	"EARNINGS_CALL_TRANSCRIPT": 7 * 24 * time.Hour,
+
+	"LISTING_STATUS":    24 * time.Hour,
+	"EARNINGS_CALENDAR": 24 * time.Hour,
+	"IPO_CALENDAR":      24 * time.Hour,
+
+	"GLOBAL_QUOTE":                 time.Minute,
+	"SYMBOL_SEARCH":                24 * time.Hour,
+	"NEWS_SENTIMENT":               15 * time.Minute,
+	"TOP_GAINERS_LOSERS":           15 * time.Minute,
+	"MARKET_STATUS":                time.Minute,
+	"CURRENCY_EXCHANGE_RATE":       time.Minute,
+	"FX_INTRADAY":                  time.Minute,
+	"FX_DAILY":                     time.Hour,
+	"FX_WEEKLY":                    6 * time.Hour,
+	"FX_MONTHLY":                   24 * time.Hour,
+	"TIME_SERIES_INTRADAY":         time.Minute,
+	"TIME_SERIES_DAILY":            time.Hour,
+	"TIME_SERIES_DAILY_ADJUSTED":   time.Hour,
+	"TIME_SERIES_WEEKLY":           6 * time.Hour,
+	"TIME_SERIES_WEEKLY_ADJUSTED":  6 * time.Hour,
+	"TIME_SERIES_MONTHLY":          24 * time.Hour,
+	"TIME_SERIES_MONTHLY_ADJUSTED": 24 * time.Hour,
+}
+
+// CacheStats cache hits and misses of a client
+type CacheStats struct {
+	Hits   uint64
+	Misses uint64
+	// SetErrors counts responses which could not be stored in the cache
+	SetErrors uint64
+}
+
+type cacheStats struct {
+	hits      uint64
+	misses    uint64
+	setErrors uint64
+}
+
+type bypassCacheKey struct{}
+
+// BypassCache returns context forcing requests to skip cache lookup; fresh responses are still cached
+func BypassCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, bypassCacheKey{}, true)
+}
+
+func isCacheBypassed(ctx context.Context) bool {
+	bypass, _ := ctx.Value(bypassCacheKey{}).(bool)
+	return bypass
+}
+
+// WithCache enables response caching with DefaultCacheTTLs
+func WithCache(cache Cache) Option {
+	return func(c *Client) {
+		c.cache = cache
+	}
+}
+
+// WithCacheTTL overrides cache TTL of function; zero ttl disables caching of the function
+func WithCacheTTL(function string, ttl time.Duration) Option {
+	return func(c *Client) {
+		ttls := make(map[string]time.Duration, len(c.cacheTTLs)+1)
+		for key, value := range c.cacheTTLs {
+			ttls[key] = value
+		}
+		ttls[function] = ttl
+		c.cacheTTLs = ttls
+	}
+}
+
+// CacheStats returns cache hits, misses and failed writes since the client creation
+func (c *Client) CacheStats() CacheStats {
+	return CacheStats{
+		Hits:      atomic.LoadUint64(&c.stats.hits),
+		Misses:    atomic.LoadUint64(&c.stats.misses),
+		SetErrors: atomic.LoadUint64(&c.stats.setErrors),
+	}
+}
+
+// cacheKey identifies request by function and params, API key is never part of it
+func cacheKey(function string, params url.Values) string {
+	return function + encodeParams(params)
+}
+
+// LRUCache in-memory Cache evicting least recently used entries, safe for concurrent use
+type LRUCache struct {
+	mu       sync.Mutex
+	now      func() time.Time
+	capacity int
+	entries  *list.List
+	index    map[string]*list.Element
+}
+
+type lruEntry struct {
+	key     string
+	value   []byte
+	expires time.Time
+}
+
+// NewLRUCache creates LRUCache holding up to capacity responses, capacity below 1 is raised to 1
+func NewLRUCache(capacity int) *LRUCache {
+	if capacity < 1 {
+		capacity = 1
+	}
+	return &LRUCache{
+		now:      time.Now,
+		capacity: capacity,
+		entries:  list.New(),
+		index:    map[string]*list.Element{},
+	}
+}
+
+// Get implements Cache
+func (c *LRUCache) Get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.index[key]
+	if !ok {
+		return nil, false
+	}
+	entry := element.Value.(*lruEntry)
+	if !c.now().Before(entry.expires) {
+		c.remove(element)
+		return nil, false
+	}
+	c.entries.MoveToFront(element)
+	return entry.value, true
+}
+
+// Set implements Cache
+func (c *LRUCache) Set(key string, value []byte, ttl time.Duration) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	expires := c.now().Add(ttl)
+	if element, ok := c.index[key]; ok {
+		entry := element.Value.(*lruEntry)
+		entry.value = value
+		entry.expires = expires
+		c.entries.MoveToFront(element)
+		return nil
+	}
+
+	c.index[key] = c.entries.PushFront(&lruEntry{key: key, value: value, expires: expires})
+	for c.entries.Len() > c.capacity {
+		c.remove(c.entries.Back())
+	}
+	return nil
+}
+
+// Len returns number of cached entries including expired ones not evicted yet
+func (c *LRUCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.entries.Len()
+}
+
+func (c *LRUCache) remove(element *list.Element) {
+	c.entries.Remove(element)
+	delete(c.index, element.Value.(*lruEntry).key)
+}
+
+// FileCache Cache storing every response in a separate file of dir
+type FileCache struct {
+	dir string
+	now func() time.Time
+}
+
+// NewFileCache creates FileCache in dir, creating the directory if needed
+func NewFileCache(dir string) (*FileCache, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, errors.Wrapf(err, "Cannot create cache dir '%s'", dir)
+	}
+	return &FileCache{dir: dir, now: time.Now}, nil
+}
+
+// Get implements Cache
+func (c *FileCache) Get(key string) ([]byte, bool) {
+	path := c.path(key)
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, false
+	}
+
+	// first line is expiration time in unix nanoseconds
+	newline := bytes.IndexByte(data, '\n')
+	if newline < 0 {
+		return nil, false
+	}
+	expires, err := strconv.ParseInt(string(data[:newline]), 10, 64)
+	if err != nil {
+		return nil, false
+	}
+	if c.now().UnixNano() >= expires {
+		_ = os.Remove(path)
+		return nil, false
+	}
+	return data[newline+1:], true
+}
+
+// Set implements Cache
+func (c *FileCache) Set(key string, value []byte, ttl time.Duration) error {
+	tmp, err := ioutil.TempFile(c.dir, ".tmp-")
+	if err != nil {
+		return errors.Wrap(err, "Cannot create cache file")
+	}
+	defer os.Remove(tmp.Name())
+
+	header := strconv.FormatInt(c.now().Add(ttl).UnixNano(), 10) + "\n"
+	if _, err := tmp.WriteString(header); err != nil {
+		tmp.Close()
+		return errors.Wrap(err, "Cannot write cache file")
+	}
+	if _, err := tmp.Write(value); err != nil {
+		tmp.Close()
+		return errors.Wrap(err, "Cannot write cache file")
+	}
+	if err := tmp.Close(); err != nil {
+		return errors.Wrap(err, "Cannot write cache file")
+	}
+	// rename is atomic, so concurrent readers never see partially written files
+	return errors.Wrap(os.Rename(tmp.Name(), c.path(key)), "Cannot write cache file")
+}
+
+func (c *FileCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:]))
+}
@@ -0,0 +1,162 @@
+package alphavantage
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCacheKey(t *testing.T) {
+	assert.Equal(t, "OVERVIEW&symbol=IBM", cacheKey("OVERVIEW", symbolParams("IBM")))
+	assert.Equal(t, "TIME_SERIES_DAILY&outputsize=full&symbol=IBM", cacheKey("TIME_SERIES_DAILY", map[string][]string{
+		"symbol":     {"IBM"},
+		"outputsize": {"full"},
+	}))
+}
+
+func TestLRUCache(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2020, 9, 1, 0, 0, 0, 0, time.UTC)}
+	cache := NewLRUCache(2)
+	cache.now = clock.Now
+
+	require.NoError(t, cache.Set("a", []byte("1"), time.Minute))
+	require.NoError(t, cache.Set("b", []byte("2"), time.Hour))
+
+	value, ok := cache.Get("a")
+	require.True(t, ok)
+	assert.Equal(t, "1", string(value))
+
+	// "b" is least recently used now
+	require.NoError(t, cache.Set("c", []byte("3"), time.Hour))
+	assert.Equal(t, 2, cache.Len())
+	_, ok = cache.Get("b")
+	assert.False(t, ok)
+
+	clock.Add(time.Minute)
+	_, ok = cache.Get("a")
+	assert.False(t, ok, "expired")
+	value, ok = cache.Get("c")
+	require.True(t, ok)
+	assert.Equal(t, "3", string(value))
+	assert.Equal(t, 1, cache.Len())
+}
+
+func TestLRUCacheCapacity(t *testing.T) {
+	for _, capacity := range []int{0, -1} {
+		cache := NewLRUCache(capacity)
+		require.NoError(t, cache.Set("a", []byte("1"), time.Hour))
+		value, ok := cache.Get("a")
+		require.True(t, ok, capacity)
+		assert.Equal(t, "1", string(value))
+	}
+}
+
+func TestFileCache(t *testing.T) {
+	dir, err := ioutil.TempDir("", "alphavantage-cache")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	clock := &fakeClock{now: time.Date(2020, 9, 1, 0, 0, 0, 0, time.UTC)}
+	cache, err := NewFileCache(dir)
+	require.NoError(t, err)
+	cache.now = clock.Now
+
+	_, ok := cache.Get("OVERVIEW&symbol=IBM")
+	assert.False(t, ok)
+
+	require.NoError(t, cache.Set("OVERVIEW&symbol=IBM", []byte(`{"Symbol": "IBM"}`), time.Hour))
+	value, ok := cache.Get("OVERVIEW&symbol=IBM")
+	require.True(t, ok)
+	assert.Equal(t, `{"Symbol": "IBM"}`, string(value))
+
+	files, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(files))
+
+	clock.Add(time.Hour)
+	_, ok = cache.Get("OVERVIEW&symbol=IBM")
+	assert.False(t, ok)
+	files, err = ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(files))
+}
+
+func TestClientCache(t *testing.T) {
+	httpClient := &fakeHTTPClient{
+		StatusCode: http.StatusOK,
+		Result:     []byte(`{"Symbol": "IBM", "LatestQuarter": "2020-06-30"}`),
+	}
+	cache := NewLRUCache(10)
+	client := NewClient(WithHTTPClient(httpClient), WithAPIKey("first"), WithCache(cache))
+	ctx := context.TODO()
+
+	_, err := client.CompanyProfile(ctx, "IBM")
+	require.NoError(t, err)
+	httpClient.Request = nil
+
+	data, err := client.CompanyProfile(ctx, "IBM")
+	require.NoError(t, err)
+	assert.Equal(t, "IBM", data.Symbol)
+	assert.Nil(t, httpClient.Request, "served from cache")
+	assert.Equal(t, CacheStats{Hits: 1, Misses: 1}, client.CacheStats())
+
+	// API key is not a part of cache key
+	other := NewClient(WithHTTPClient(httpClient), WithAPIKey("second"), WithCache(cache))
+	_, err = other.CompanyProfile(ctx, "IBM")
+	require.NoError(t, err)
+	assert.Nil(t, httpClient.Request, "served from cache")
+
+	_, err = client.CompanyProfile(BypassCache(ctx), "IBM")
+	require.NoError(t, err)
+	assert.NotNil(t, httpClient.Request, "cache bypassed")
+	assert.Equal(t, CacheStats{Hits: 1, Misses: 2}, client.CacheStats())
+}
+
+func TestClientCacheSkipsErrorsAndUncachedFunctions(t *testing.T) {
+	httpClient := &fakeHTTPClient{
+		StatusCode: http.StatusOK,
+		Result:     []byte(`{"Note": "Our standard API call frequency is 5 calls per minute."}`),
+	}
+	cache := NewLRUCache(10)
+	client := NewClient(WithHTTPClient(httpClient), WithCache(cache), WithCacheTTL("BALANCE_SHEET", 0))
+	ctx := context.TODO()
+
+	_, err := client.CompanyProfile(ctx, "IBM")
+	require.Error(t, err)
+	assert.Equal(t, 0, cache.Len())
+
+	httpClient.Result = []byte(`{"symbol": "IBM", "annualReports": [], "quarterlyReports": []}`)
+	_, err = client.BalanceSheets(ctx, "IBM")
+	require.NoError(t, err)
+	assert.Equal(t, 0, cache.Len())
+	assert.Equal(t, 7*24*time.Hour, DefaultCacheTTLs["BALANCE_SHEET"], "defaults are not modified")
+}
+
+type failingCache struct{}
+
+func (failingCache) Get(key string) ([]byte, bool) {
+	return nil, false
+}
+
+func (failingCache) Set(key string, value []byte, ttl time.Duration) error {
+	return errors.New("disk full")
+}
+
+func TestClientCacheSetErrors(t *testing.T) {
+	httpClient := &fakeHTTPClient{
+		StatusCode: http.StatusOK,
+		Result:     []byte(`{"Symbol": "IBM", "LatestQuarter": "2020-06-30"}`),
+	}
+	client := NewClient(WithHTTPClient(httpClient), WithCache(failingCache{}))
+
+	_, err := client.CompanyProfile(context.TODO(), "IBM")
+	require.NoError(t, err, "cache errors do not fail requests")
+	assert.Equal(t, CacheStats{Misses: 1, SetErrors: 1}, client.CacheStats())
+}
@@ -0,0 +1,132 @@
+package alphavantage
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+// Horizon how far ahead EARNINGS_CALENDAR looks
+type Horizon string
+
+// Supported earnings calendar horizons
+const (
+	Horizon3Month  Horizon = "3month"
+	Horizon6Month  Horizon = "6month"
+	Horizon12Month Horizon = "12month"
+)
+
+// EarningsCalendarOptions optional parameters of EARNINGS_CALENDAR requests, the zero value returns all
+// companies expected to report in the next 3 months
+type EarningsCalendarOptions struct {
+	Symbol  string
+	Horizon Horizon
+}
+
+func (o EarningsCalendarOptions) params() url.Values {
+	params := url.Values{}
+	if o.Symbol != "" {
+		params.Set("symbol", o.Symbol)
+	}
+	if o.Horizon != "" {
+		params.Set("horizon", string(o.Horizon))
+	}
+	return params
+}
+
+// EarningsCalendarEntry expected earnings report
+type EarningsCalendarEntry struct {
+	Symbol           string      `json:"symbol"`
+	Name             string      `json:"name"`
+	ReportDate       Date        `json:"reportDate"`
+	FiscalDateEnding Date        `json:"fiscalDateEnding"`
+	Estimate         NullDecimal `json:"estimate"`
+	Currency         string      `json:"currency"`
+}
+
+// IPOCalendarEntry expected IPO
+type IPOCalendarEntry struct {
+	Symbol         string      `json:"symbol"`
+	Name           string      `json:"name"`
+	IPODate        Date        `json:"ipoDate"`
+	PriceRangeLow  NullDecimal `json:"priceRangeLow"`
+	PriceRangeHigh NullDecimal `json:"priceRangeHigh"`
+	Currency       string      `json:"currency"`
+	Exchange       string      `json:"exchange"`
+}
+
+// EarningsCalendar makes API request and returns parsed response
+func (c *Client) EarningsCalendar(ctx context.Context, opts EarningsCalendarOptions) ([]EarningsCalendarEntry, error) {
+	var res []EarningsCalendarEntry
+	var parseErrs ParseErrors
+	err := c.requestCSV(ctx, "EARNINGS_CALENDAR", opts.params(), func(row map[string]string) error {
+		e, err := fromEarningsCalendarEntry(row, c.collectParseErrors)
+		if err != nil {
+			if parseErrs.collect(err) {
+				return nil
+			}
+			return errors.Wrap(err, "EarningsCalendar parsing error")
+		}
+		res = append(res, e)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "EarningsCalendar error")
+	}
+	if len(parseErrs) > 0 {
+		return nil, errors.Wrap(parseErrs, "EarningsCalendar parsing error")
+	}
+	return res, nil
+}
+
+// IPOCalendar makes API request and returns parsed response
+func (c *Client) IPOCalendar(ctx context.Context) ([]IPOCalendarEntry, error) {
+	var res []IPOCalendarEntry
+	var parseErrs ParseErrors
+	err := c.requestCSV(ctx, "IPO_CALENDAR", url.Values{}, func(row map[string]string) error {
+		e, err := fromIPOCalendarEntry(row, c.collectParseErrors)
+		if err != nil {
+			if parseErrs.collect(err) {
+				return nil
+			}
+			return errors.Wrap(err, "IPOCalendar parsing error")
+		}
+		res = append(res, e)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "IPOCalendar error")
+	}
+	if len(parseErrs) > 0 {
+		return nil, errors.Wrap(parseErrs, "IPOCalendar parsing error")
+	}
+	return res, nil
+}
+
+func fromEarningsCalendarEntry(row map[string]string, collectAll bool) (EarningsCalendarEntry, error) {
+	p := newFieldParser("EarningsCalendarEntry", row["symbol"], collectAll)
+	res := EarningsCalendarEntry{
+		Symbol:           row["symbol"],
+		Name:             row["name"],
+		ReportDate:       p.date("reportDate", row["reportDate"]),
+		FiscalDateEnding: p.date("fiscalDateEnding", row["fiscalDateEnding"]),
+		Estimate:         p.nullDecimal("estimate", row["estimate"]),
+		Currency:         row["currency"],
+	}
+	return res, p.err()
+}
+
+func fromIPOCalendarEntry(row map[string]string, collectAll bool) (IPOCalendarEntry, error) {
+	p := newFieldParser("IPOCalendarEntry", row["symbol"], collectAll)
+	res := IPOCalendarEntry{
+		Symbol:         row["symbol"],
+		Name:           row["name"],
+		IPODate:        p.date("ipoDate", row["ipoDate"]),
+		PriceRangeLow:  p.nullDecimal("priceRangeLow", row["priceRangeLow"]),
+		PriceRangeHigh: p.nullDecimal("priceRangeHigh", row["priceRangeHigh"]),
+		Currency:       row["currency"],
+		Exchange:       row["exchange"],
+	}
+	return res, p.err()
+}
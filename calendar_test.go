@@ -0,0 +1,58 @@
+package alphavantage
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEarningsCalendar(t *testing.T) {
+	httpClient := &fakeHTTPClient{StatusCode: http.StatusOK, Result: []byte("symbol,name,reportDate,fiscalDateEnding,estimate,currency\r\n" +
+		"IBM,International Business Machines Corp,2020-10-19,2020-09-30,2.58,USD\r\n" +
+		"IBMX,Example Corp,2020-10-21,2020-09-30,,USD\r\n")}
+	client := NewClient(WithHTTPClient(httpClient), WithAPIKey("demo"))
+
+	res, err := client.EarningsCalendar(context.TODO(), EarningsCalendarOptions{Symbol: "IBM", Horizon: Horizon12Month})
+	require.NoError(t, err)
+	assert.Equal(t, "https://www.alphavantage.co/query?function=EARNINGS_CALENDAR&horizon=12month&symbol=IBM&apikey=demo", httpClient.Request.URL.String())
+
+	require.Len(t, res, 2)
+	assert.Equal(t, "IBM", res[0].Symbol)
+	assert.Equal(t, "International Business Machines Corp", res[0].Name)
+	assert.Equal(t, "2020-10-19", res[0].ReportDate.String())
+	assert.Equal(t, "2020-09-30", res[0].FiscalDateEnding.String())
+	assert.Equal(t, "2.58", res[0].Estimate.String())
+	assert.Equal(t, "USD", res[0].Currency)
+	assert.False(t, res[1].Estimate.Valid)
+}
+
+func TestIPOCalendar(t *testing.T) {
+	httpClient := &fakeHTTPClient{StatusCode: http.StatusOK, Result: []byte("symbol,name,ipoDate,priceRangeLow,priceRangeHigh,currency,exchange\r\n" +
+		"ACAXU,Alset Capital Acquisition Corp - Units,2022-02-01,10,10,USD,NASDAQ\r\n" +
+		"GNLN,Greenlane Holdings,2022-02-03,0,0,USD,NASDAQ\r\n" +
+		"XYZ,Example Corp,2022-02-04,,,USD,NYSE\r\n")}
+	client := NewClient(WithHTTPClient(httpClient), WithAPIKey("demo"))
+
+	res, err := client.IPOCalendar(context.TODO())
+	require.NoError(t, err)
+	assert.Equal(t, "https://www.alphavantage.co/query?function=IPO_CALENDAR&apikey=demo", httpClient.Request.URL.String())
+
+	require.Len(t, res, 3)
+	assert.Equal(t, IPOCalendarEntry{
+		Symbol:         "ACAXU",
+		Name:           "Alset Capital Acquisition Corp - Units",
+		IPODate:        res[0].IPODate,
+		PriceRangeLow:  NewNullDecimal(NewDecimal(10, 0)),
+		PriceRangeHigh: NewNullDecimal(NewDecimal(10, 0)),
+		Currency:       "USD",
+		Exchange:       "NASDAQ",
+	}, res[0])
+	assert.Equal(t, "2022-02-01", res[0].IPODate.String())
+	assert.True(t, res[1].PriceRangeLow.Valid)
+	assert.True(t, res[1].PriceRangeLow.Decimal.IsZero())
+	assert.False(t, res[2].PriceRangeLow.Valid)
+	assert.False(t, res[2].PriceRangeHigh.Valid)
+}
@@ -0,0 +1,224 @@
+package alphavantage
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultClient is used by the package-level functions
+var DefaultClient = NewClient()
+
+// Client is a reusable alphavantage API client
+type Client struct {
+	apiKey     string
+	baseURL    string
+	httpClient HTTPClient
+	userAgent  string
+	timeout    time.Duration
+	limiter    Limiter
+	retry      *RetryPolicy
+	cache      Cache
+	cacheTTLs  map[string]time.Duration
+	stats      *cacheStats
+
+	collectParseErrors bool
+}
+
+// Option configures Client
+type Option func(*Client)
+
+// WithAPIKey sets alphavantage API key
+func WithAPIKey(apiKey string) Option {
+	return func(c *Client) {
+		c.apiKey = apiKey
+	}
+}
+
+// WithBaseURL overrides alphavantage URL, e.g. to point the client to a local stand-in server
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) {
+		c.baseURL = baseURL
+	}
+}
+
+// WithHTTPClient sets HTTPClient used to make requests
+func WithHTTPClient(httpClient HTTPClient) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithUserAgent sets User-Agent header sent with every request
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) {
+		c.userAgent = userAgent
+	}
+}
+
+// WithTimeout sets default timeout applied to every request; zero means no timeout
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *Client) {
+		c.timeout = timeout
+	}
+}
+
+// WithLimiter throttles requests made by the client, e.g. WithLimiter(NewRateLimiter(FreeQuota)).
+// Share one Limiter between clients using the same API key.
+func WithLimiter(limiter Limiter) Option {
+	return func(c *Client) {
+		c.limiter = limiter
+	}
+}
+
+// NewClient creates Client configured with opts
+func NewClient(opts ...Option) *Client {
+	c := &Client{
+		baseURL:    aplhavantageURL,
+		httpClient: http.DefaultClient,
+		cacheTTLs:  DefaultCacheTTLs,
+		stats:      &cacheStats{},
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// withCredentials returns a shallow copy of the client using given httpClient and apiKey
+func (c *Client) withCredentials(httpClient HTTPClient, apiKey string) *Client {
+	res := *c
+	res.httpClient = httpClient
+	res.apiKey = apiKey
+	return &res
+}
+
+func (c *Client) request(ctx context.Context, function string, params url.Values, v interface{}) error {
+	body, err := c.fetch(ctx, function, params)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(body, v)
+}
+
+// fetch returns response body from cache or alphavantage
+func (c *Client) fetch(ctx context.Context, function string, params url.Values) ([]byte, error) {
+	if !c.isCached(function) {
+		return c.fetchRemote(ctx, function, params)
+	}
+	ttl := c.cacheTTLs[function]
+
+	key := cacheKey(function, params)
+	if !isCacheBypassed(ctx) {
+		if body, ok := c.cache.Get(key); ok {
+			atomic.AddUint64(&c.stats.hits, 1)
+			return body, nil
+		}
+	}
+	atomic.AddUint64(&c.stats.misses, 1)
+
+	body, err := c.fetchRemote(ctx, function, params)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.cache.Set(key, body, ttl); err != nil {
+		atomic.AddUint64(&c.stats.setErrors, 1)
+	}
+	return body, nil
+}
+
+// isCached reports whether responses of function are cached
+func (c *Client) isCached(function string) bool {
+	return c.cache != nil && c.cacheTTLs[function] > 0
+}
+
+// fetchRemote calls alphavantage and returns the whole response body
+func (c *Client) fetchRemote(ctx context.Context, function string, params url.Values) ([]byte, error) {
+	ctx, cancel := c.withTimeout(ctx)
+	defer cancel()
+
+	var body []byte
+	err := c.retryRemote(ctx, function, params, func(req *http.Request) (err error) {
+		body, err = fetch(c.httpClient, req)
+		return err
+	})
+	return body, err
+}
+
+// openRemote calls alphavantage and returns response body for streaming, the caller must close it
+func (c *Client) openRemote(ctx context.Context, function string, params url.Values) (io.ReadCloser, error) {
+	ctx, cancel := c.withTimeout(ctx)
+
+	var body io.ReadCloser
+	err := c.retryRemote(ctx, function, params, func(req *http.Request) (err error) {
+		body, err = open(c.httpClient, req)
+		return err
+	})
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	return &cancelOnClose{ReadCloser: body, cancel: cancel}, nil
+}
+
+// withTimeout applies the client timeout to ctx
+func (c *Client) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if c.timeout > 0 {
+		return context.WithTimeout(ctx, c.timeout)
+	}
+	return ctx, func() {}
+}
+
+// retryRemote makes HTTP request with call, retrying transient failures according to the client RetryPolicy
+func (c *Client) retryRemote(ctx context.Context, function string, params url.Values, call func(req *http.Request) error) error {
+	req, err := newRequest(ctx, buildQueryURL(c.baseURL, c.apiKey, function, params))
+	if err != nil {
+		return err
+	}
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+
+	for attempt := 1; ; attempt++ {
+		if c.limiter != nil {
+			if err := c.limiter.Wait(ctx); err != nil {
+				return errors.Wrap(err, "Rate limiter error")
+			}
+		}
+
+		err := call(req)
+		if err == nil {
+			return nil
+		}
+
+		delay, ok := c.retry.retryDelay(attempt, err)
+		if !ok || !withinDeadline(ctx, delay) {
+			return err
+		}
+		if c.retry.OnRetry != nil {
+			c.retry.OnRetry(RetryAttempt{Attempt: attempt, Err: err, Delay: delay})
+		}
+		if sleepErr := sleepContext(ctx, delay); sleepErr != nil {
+			return errors.Wrapf(sleepErr, "Retry interrupted, last attempt error: %s", err)
+		}
+	}
+}
+
+// cancelOnClose releases request context once the response body is closed
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+// Close implements io.Closer
+func (b *cancelOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
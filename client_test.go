@@ -0,0 +1,77 @@
+package alphavantage
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildQueryURL(t *testing.T) {
+	actualResult := buildQueryURL("http://127.0.0.1:8080/", "demo", "TIME_SERIES_DAILY", map[string][]string{
+		"symbol":     {"BRK.B"},
+		"outputsize": {"full"},
+		"keywords":   {"tesla motors"},
+	})
+	assert.Equal(t, "http://127.0.0.1:8080/query?function=TIME_SERIES_DAILY&keywords=tesla+motors&outputsize=full&symbol=BRK.B&apikey=demo", actualResult)
+}
+
+func TestClientOptions(t *testing.T) {
+	var requestURL, userAgent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestURL = r.URL.String()
+		userAgent = r.Header.Get("User-Agent")
+		_, _ = w.Write([]byte(`{"Symbol": "IBM", "LatestQuarter": "2020-06-30"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(
+		WithAPIKey("secret"),
+		WithBaseURL(server.URL),
+		WithHTTPClient(server.Client()),
+		WithUserAgent("alphavantage-test"),
+	)
+
+	data, err := client.CompanyProfile(context.TODO(), "IBM")
+	require.NoError(t, err)
+	require.Equal(t, "IBM", data.Symbol)
+	require.Equal(t, "/query?function=OVERVIEW&symbol=IBM&apikey=secret", requestURL)
+	require.Equal(t, "alphavantage-test", userAgent)
+}
+
+func TestClientTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(
+		WithBaseURL(server.URL),
+		WithHTTPClient(server.Client()),
+		WithTimeout(10*time.Millisecond),
+	)
+
+	_, err := client.CompanyProfile(context.TODO(), "IBM")
+	require.Error(t, err)
+	require.True(t, errors.Is(err, context.DeadlineExceeded))
+}
+
+func TestPackageFunctionsUseDefaultClient(t *testing.T) {
+	httpClient := &fakeHTTPClient{
+		StatusCode: http.StatusOK,
+		Result:     []byte(`{"Symbol": "IBM", "LatestQuarter": "2020-06-30"}`),
+	}
+
+	_, err := CompanyProfile(context.TODO(), httpClient, "demo", "IBM")
+	require.NoError(t, err)
+	require.Equal(t, "https://www.alphavantage.co/query?function=OVERVIEW&symbol=IBM&apikey=demo", httpClient.Request.URL.String())
+	require.Equal(t, "", DefaultClient.apiKey)
+}
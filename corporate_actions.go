@@ -0,0 +1,184 @@
+package alphavantage
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// SplitRatio stock split ratio, e.g. 2:1 is Numerator 2 and Denominator 1; reverse splits have Numerator < Denominator
+type SplitRatio struct {
+	Numerator   int64 `json:"numerator"`
+	Denominator int64 `json:"denominator"`
+}
+
+// ParseSplitRatio parses "2:1", "3/2" or decimal split factors like "2.0000" and "0.5", the ratio is reduced
+func ParseSplitRatio(v string) (SplitRatio, error) {
+	v = strings.TrimSpace(v)
+	var r *big.Rat
+	if i := strings.IndexAny(v, ":/"); i >= 0 {
+		numerator, err := strconv.ParseInt(strings.TrimSpace(v[:i]), 10, 64)
+		if err != nil {
+			return SplitRatio{}, errors.Wrapf(err, "Cannot parse split ratio '%s'", v)
+		}
+		denominator, err := strconv.ParseInt(strings.TrimSpace(v[i+1:]), 10, 64)
+		if err != nil {
+			return SplitRatio{}, errors.Wrapf(err, "Cannot parse split ratio '%s'", v)
+		}
+		if numerator <= 0 || denominator <= 0 {
+			return SplitRatio{}, errors.Errorf("Cannot parse split ratio '%s': must be positive", v)
+		}
+		r = big.NewRat(numerator, denominator)
+	} else {
+		d, err := ParseDecimal(v)
+		if err != nil {
+			return SplitRatio{}, errors.Wrapf(err, "Cannot parse split ratio '%s'", v)
+		}
+		if d.Sign() <= 0 {
+			return SplitRatio{}, errors.Errorf("Cannot parse split ratio '%s': must be positive", v)
+		}
+		r = d.Rat()
+	}
+	if !r.Num().IsInt64() || !r.Denom().IsInt64() {
+		return SplitRatio{}, errors.Errorf("Cannot parse split ratio '%s': out of range", v)
+	}
+	return SplitRatio{Numerator: r.Num().Int64(), Denominator: r.Denom().Int64()}, nil
+}
+
+// IsZero reports whether the ratio is absent
+func (r SplitRatio) IsZero() bool {
+	return r.Denominator == 0
+}
+
+// Rat returns the ratio as a number, e.g. 2 for 2:1 split
+func (r SplitRatio) Rat() *big.Rat {
+	if r.IsZero() {
+		return new(big.Rat)
+	}
+	return big.NewRat(r.Numerator, r.Denominator)
+}
+
+// String converts SplitRatio to "2:1" form, absent ratio is converted to "None"
+func (r SplitRatio) String() string {
+	if r.IsZero() {
+		return "None"
+	}
+	return fmt.Sprintf("%d:%d", r.Numerator, r.Denominator)
+}
+
+// LastSplitRatio parses LastSplitFactor, zero SplitRatio is returned when the company never split
+func (p CompanyProfileInfo) LastSplitRatio() (SplitRatio, error) {
+	if isAbsentValue(p.LastSplitFactor) {
+		return SplitRatio{}, nil
+	}
+	return ParseSplitRatio(p.LastSplitFactor)
+}
+
+// Dividend dividend payment
+type Dividend struct {
+	ExDividendDate  Date    `json:"exDividendDate"`
+	DeclarationDate Date    `json:"declarationDate"`
+	RecordDate      Date    `json:"recordDate"`
+	PaymentDate     Date    `json:"paymentDate"`
+	Amount          Decimal `json:"amount"`
+}
+
+// Split stock split
+type Split struct {
+	EffectiveDate Date       `json:"effectiveDate"`
+	SplitFactor   SplitRatio `json:"splitFactor"`
+}
+
+// Dividends makes API request and returns parsed response
+func (c *Client) Dividends(ctx context.Context, symbol string) ([]Dividend, error) {
+	response := rawDividendsResponse{}
+	if err := c.request(ctx, "DIVIDENDS", symbolParams(symbol), &response); err != nil {
+		return nil, errors.Wrap(err, "Dividends error")
+	}
+	res := make([]Dividend, 0, len(response.Data))
+	var parseErrs ParseErrors
+	for _, raw := range response.Data {
+		d, err := fromDividend(raw, c.collectParseErrors)
+		if err != nil {
+			if parseErrs.collect(err) {
+				continue
+			}
+			return nil, errors.Wrap(err, "Dividends parsing error")
+		}
+		res = append(res, d)
+	}
+	if len(parseErrs) > 0 {
+		return nil, errors.Wrap(parseErrs, "Dividends parsing error")
+	}
+	return res, nil
+}
+
+// Splits makes API request and returns parsed response
+func (c *Client) Splits(ctx context.Context, symbol string) ([]Split, error) {
+	response := rawSplitsResponse{}
+	if err := c.request(ctx, "SPLITS", symbolParams(symbol), &response); err != nil {
+		return nil, errors.Wrap(err, "Splits error")
+	}
+	res := make([]Split, 0, len(response.Data))
+	var parseErrs ParseErrors
+	for _, raw := range response.Data {
+		s, err := fromSplit(raw, c.collectParseErrors)
+		if err != nil {
+			if parseErrs.collect(err) {
+				continue
+			}
+			return nil, errors.Wrap(err, "Splits parsing error")
+		}
+		res = append(res, s)
+	}
+	if len(parseErrs) > 0 {
+		return nil, errors.Wrap(parseErrs, "Splits parsing error")
+	}
+	return res, nil
+}
+
+type rawDividendsResponse struct {
+	Data []rawDividend `json:"data"`
+}
+
+type rawDividend struct {
+	ExDividendDate  string `json:"ex_dividend_date"`
+	DeclarationDate string `json:"declaration_date"`
+	RecordDate      string `json:"record_date"`
+	PaymentDate     string `json:"payment_date"`
+	Amount          string `json:"amount"`
+}
+
+type rawSplitsResponse struct {
+	Data []rawSplit `json:"data"`
+}
+
+type rawSplit struct {
+	EffectiveDate string `json:"effective_date"`
+	SplitFactor   string `json:"split_factor"`
+}
+
+func fromDividend(raw rawDividend, collectAll bool) (Dividend, error) {
+	p := newFieldParser("Dividend", raw.ExDividendDate, collectAll)
+	res := Dividend{
+		ExDividendDate:  p.date("ex_dividend_date", raw.ExDividendDate),
+		DeclarationDate: p.date("declaration_date", raw.DeclarationDate),
+		RecordDate:      p.date("record_date", raw.RecordDate),
+		PaymentDate:     p.date("payment_date", raw.PaymentDate),
+		Amount:          p.decimal("amount", raw.Amount),
+	}
+	return res, p.err()
+}
+
+func fromSplit(raw rawSplit, collectAll bool) (Split, error) {
+	p := newFieldParser("Split", raw.EffectiveDate, collectAll)
+	res := Split{
+		EffectiveDate: p.date("effective_date", raw.EffectiveDate),
+		SplitFactor:   p.splitRatio("split_factor", raw.SplitFactor),
+	}
+	return res, p.err()
+}
@@ -0,0 +1,135 @@
+package alphavantage
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseSplitRatio(t *testing.T) {
+	testCases := map[string]SplitRatio{
+		"2:1":    {Numerator: 2, Denominator: 1},
+		"3:2":    {Numerator: 3, Denominator: 2},
+		"1:10":   {Numerator: 1, Denominator: 10},
+		"4:2":    {Numerator: 2, Denominator: 1},
+		"3/2":    {Numerator: 3, Denominator: 2},
+		"2.0000": {Numerator: 2, Denominator: 1},
+		"1.5":    {Numerator: 3, Denominator: 2},
+		"0.1":    {Numerator: 1, Denominator: 10},
+		" 20 ":   {Numerator: 20, Denominator: 1},
+	}
+
+	for input, expectedResult := range testCases {
+		actualResult, err := ParseSplitRatio(input)
+		require.NoError(t, err, input)
+		assert.Equal(t, expectedResult, actualResult, input)
+	}
+
+	for _, input := range []string{"", "None", "2:", ":1", "2:0", "-2:1", "0", "-1.5", "two for one"} {
+		_, err := ParseSplitRatio(input)
+		assert.Error(t, err, input)
+	}
+}
+
+func TestSplitRatioHelpers(t *testing.T) {
+	r := SplitRatio{Numerator: 3, Denominator: 2}
+	assert.Equal(t, "3:2", r.String())
+	assert.Equal(t, 0, r.Rat().Cmp(NewDecimal(15, -1).Rat()))
+	assert.False(t, r.IsZero())
+	assert.True(t, SplitRatio{}.IsZero())
+	assert.Equal(t, "None", SplitRatio{}.String())
+}
+
+func TestCompanyProfileLastSplitRatio(t *testing.T) {
+	var profile CompanyProfileInfo
+	require.NoError(t, json.Unmarshal([]byte(`{"Symbol": "IBM", "LastSplitFactor": "2:1", "LastSplitDate": "1999-05-27"}`), &profile))
+	ratio, err := profile.LastSplitRatio()
+	require.NoError(t, err)
+	assert.Equal(t, SplitRatio{Numerator: 2, Denominator: 1}, ratio)
+
+	profile.LastSplitFactor = "None"
+	ratio, err = profile.LastSplitRatio()
+	require.NoError(t, err)
+	assert.True(t, ratio.IsZero())
+
+	profile.LastSplitFactor = "2-for-1"
+	_, err = profile.LastSplitRatio()
+	assert.Error(t, err)
+}
+
+func TestDividends(t *testing.T) {
+	httpClient := &fakeHTTPClient{StatusCode: http.StatusOK, Result: []byte(`
+	{
+		"symbol": "IBM",
+		"data": [
+			{
+				"ex_dividend_date": "2024-02-08",
+				"declaration_date": "2024-01-30",
+				"record_date": "2024-02-09",
+				"payment_date": "2024-03-09",
+				"amount": "1.66"
+			},
+			{
+				"ex_dividend_date": "1962-02-06",
+				"declaration_date": "None",
+				"record_date": "None",
+				"payment_date": "None",
+				"amount": "0.0125"
+			}
+		]
+	}`)}
+	client := NewClient(WithHTTPClient(httpClient), WithAPIKey("demo"))
+
+	res, err := client.Dividends(context.TODO(), "IBM")
+	require.NoError(t, err)
+	assert.Equal(t, "https://www.alphavantage.co/query?function=DIVIDENDS&symbol=IBM&apikey=demo", httpClient.Request.URL.String())
+	require.Len(t, res, 2)
+	assert.Equal(t, "2024-02-08", res[0].ExDividendDate.String())
+	assert.Equal(t, "2024-01-30", res[0].DeclarationDate.String())
+	assert.Equal(t, "2024-02-09", res[0].RecordDate.String())
+	assert.Equal(t, "2024-03-09", res[0].PaymentDate.String())
+	assert.Equal(t, "1.66", res[0].Amount.String())
+	assert.False(t, res[1].DeclarationDate.Valid())
+	assert.False(t, res[1].PaymentDate.Valid())
+	assert.Equal(t, "0.0125", res[1].Amount.String())
+}
+
+func TestSplits(t *testing.T) {
+	httpClient := &fakeHTTPClient{StatusCode: http.StatusOK, Result: []byte(`
+	{
+		"symbol": "IBM",
+		"data": [
+			{"effective_date": "2021-11-04", "split_factor": "1.0460"},
+			{"effective_date": "1999-05-27", "split_factor": "2.0000"},
+			{"effective_date": "1979-06-01", "split_factor": "4.0000"}
+		]
+	}`)}
+	client := NewClient(WithHTTPClient(httpClient), WithAPIKey("demo"))
+
+	res, err := client.Splits(context.TODO(), "IBM")
+	require.NoError(t, err)
+	assert.Equal(t, "https://www.alphavantage.co/query?function=SPLITS&symbol=IBM&apikey=demo", httpClient.Request.URL.String())
+	require.Len(t, res, 3)
+	assert.Equal(t, "2021-11-04", res[0].EffectiveDate.String())
+	assert.Equal(t, SplitRatio{Numerator: 523, Denominator: 500}, res[0].SplitFactor)
+	assert.Equal(t, "2:1", res[1].SplitFactor.String())
+	assert.Equal(t, "4:1", res[2].SplitFactor.String())
+}
+
+func TestSplitsParseError(t *testing.T) {
+	httpClient := &fakeHTTPClient{StatusCode: http.StatusOK, Result: []byte(`{"symbol": "IBM", "data": [{"effective_date": "1999-05-27", "split_factor": "0"}]}`)}
+	client := NewClient(WithHTTPClient(httpClient))
+
+	_, err := client.Splits(context.TODO(), "IBM")
+	require.Error(t, err)
+	var parseErr *ParseError
+	require.True(t, errors.As(err, &parseErr))
+	assert.Equal(t, "Split", parseErr.Statement)
+	assert.Equal(t, "1999-05-27", parseErr.Record)
+	assert.Equal(t, "split_factor", parseErr.Field)
+}
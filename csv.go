@@ -0,0 +1,71 @@
+package alphavantage
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"io"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// requestCSV makes API request returning CSV and calls fn for every row with cells keyed by the header.
+// Responses which are not cached are decoded while they are downloaded, without holding them in memory.
+func (c *Client) requestCSV(ctx context.Context, function string, params url.Values, fn func(row map[string]string) error) error {
+	if c.isCached(function) {
+		body, err := c.fetch(ctx, function, params)
+		if err != nil {
+			return err
+		}
+		return decodeCSV(bytes.NewReader(body), fn)
+	}
+
+	body, err := c.openRemote(ctx, function, params)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+	return decodeCSV(body, fn)
+}
+
+// decodeCSV reads CSV with header line and calls fn for every row with cells keyed by the header
+func decodeCSV(r io.Reader, fn func(row map[string]string) error) error {
+	reader := csv.NewReader(r)
+	reader.ReuseRecord = true
+
+	header, err := reader.Read()
+	if err == io.EOF {
+		return nil
+	}
+	if err != nil {
+		return errors.Wrap(err, "Cannot read CSV header")
+	}
+	columns := make([]string, len(header))
+	for i, name := range header {
+		columns[i] = normalizeCSVHeader(name)
+	}
+
+	for {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return errors.Wrap(err, "Cannot read CSV")
+		}
+		row := make(map[string]string, len(columns))
+		for i, column := range columns {
+			row[column] = strings.TrimSpace(record[i])
+		}
+		if err := fn(row); err != nil {
+			return err
+		}
+	}
+}
+
+// normalizeCSVHeader drops byte order mark and surrounding spaces of a column name
+func normalizeCSVHeader(name string) string {
+	return strings.TrimSpace(strings.TrimPrefix(name, "\ufeff"))
+}
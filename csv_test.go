@@ -0,0 +1,117 @@
+package alphavantage
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeCSV(t *testing.T) {
+	var rows []map[string]string
+	err := decodeCSV(strings.NewReader("\ufeffsymbol, name ,ipoDate\r\nIBM,International Business Machines, 1962-01-02\r\nAAA,\"Alpha, Inc\",\r\n"), func(row map[string]string) error {
+		rows = append(rows, row)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []map[string]string{
+		{"symbol": "IBM", "name": "International Business Machines", "ipoDate": "1962-01-02"},
+		{"symbol": "AAA", "name": "Alpha, Inc", "ipoDate": ""},
+	}, rows)
+}
+
+func TestDecodeCSVErrors(t *testing.T) {
+	calls := 0
+	err := decodeCSV(strings.NewReader(""), func(row map[string]string) error {
+		calls++
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 0, calls)
+
+	err = decodeCSV(strings.NewReader("a,b\n1,2,3\n"), func(row map[string]string) error {
+		return nil
+	})
+	assert.Error(t, err)
+
+	stop := errors.New("stop")
+	err = decodeCSV(strings.NewReader("a,b\n1,2\n3,4\n"), func(row map[string]string) error {
+		calls++
+		return stop
+	})
+	assert.Equal(t, stop, err)
+	assert.Equal(t, 1, calls)
+}
+
+// countingReader counts bytes read from Reader
+type countingReader struct {
+	io.Reader
+	read int
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.Reader.Read(p)
+	r.read += n
+	return n, err
+}
+
+// streamHTTPClient replies with Body
+type streamHTTPClient struct {
+	Body *countingReader
+}
+
+func (c *streamHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	return &http.Response{StatusCode: http.StatusOK, Body: ioutil.NopCloser(c.Body)}, nil
+}
+
+func TestRequestCSVStreamsUncachedResponses(t *testing.T) {
+	var sb strings.Builder
+	sb.WriteString("symbol,name\r\n")
+	for i := 0; i < 10000; i++ {
+		sb.WriteString("IBM,International Business Machines\r\n")
+	}
+	total := sb.Len()
+
+	testCases := map[string]struct {
+		options  []Option
+		streamed bool
+	}{
+		"uncached": {streamed: true},
+		"cached":   {options: []Option{WithCache(NewLRUCache(10)), WithCacheTTL("LISTING_STATUS", time.Hour)}},
+	}
+	for name, tc := range testCases {
+		httpClient := &streamHTTPClient{Body: &countingReader{Reader: strings.NewReader(sb.String())}}
+		client := NewClient(append(tc.options, WithHTTPClient(httpClient))...)
+
+		readBeforeFirstRow := -1
+		rows := 0
+		err := client.requestCSV(context.TODO(), "LISTING_STATUS", url.Values{}, func(row map[string]string) error {
+			if readBeforeFirstRow < 0 {
+				readBeforeFirstRow = httpClient.Body.read
+			}
+			rows++
+			return nil
+		})
+		require.NoError(t, err, name)
+		assert.Equal(t, 10000, rows, name)
+		assert.Equal(t, tc.streamed, readBeforeFirstRow < total, name)
+	}
+}
+
+func TestRequestCSVSoftError(t *testing.T) {
+	httpClient := &streamHTTPClient{Body: &countingReader{Reader: strings.NewReader(`  {"Error Message": "Invalid API call."}`)}}
+	client := NewClient(WithHTTPClient(httpClient))
+
+	err := client.requestCSV(context.TODO(), "LISTING_STATUS", url.Values{}, func(row map[string]string) error {
+		return errors.New("unexpected row")
+	})
+	assert.True(t, errors.Is(err, ErrInvalidSymbol))
+}
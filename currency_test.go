@@ -0,0 +1,26 @@
+package alphavantage
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsCurrencyCode(t *testing.T) {
+	for _, code := range []string{"USD", "EUR", "JPY", "GBP", "CHF", "XAU"} {
+		assert.True(t, IsCurrencyCode(code), code)
+	}
+	for _, code := range []string{"", "usd", "US", "USDT", "BTC", "EUD", "XXX"} {
+		assert.False(t, IsCurrencyCode(code), code)
+	}
+}
+
+func TestParseCurrencyCode(t *testing.T) {
+	res, err := parseCurrencyCode(" eur ")
+	require.NoError(t, err)
+	assert.Equal(t, "EUR", res)
+
+	_, err = parseCurrencyCode("EUD")
+	assert.EqualError(t, err, "Unknown currency code 'EUD'")
+}
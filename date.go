@@ -12,28 +12,51 @@ const dateLayout = "2006-01-02"
 // Date just money type
 type Date time.Time
 
-// panicParseDate parses alphavantage date types
-func panicParseDate(v string) Date {
+// parseDate parses alphavantage date types, "None", "-" and empty values are parsed as zero Date
+func parseDate(v string) (Date, error) {
+	if isAbsentValue(v) {
+		return Date{}, nil
+	}
 	res, err := time.Parse(dateLayout, v)
 	if err != nil {
-		panic(errors.Wrapf(err, "Cannot parse '%s'", v))
+		return Date{}, errors.Wrapf(err, "Cannot parse '%s'", v)
 	}
-	return Date(res.UTC())
+	return Date(res.UTC()), nil
 }
 
 // UnmarshalJSON decodes DateKey
-func (d *Date) UnmarshalJSON(b []byte) (err error) {
+func (d *Date) UnmarshalJSON(b []byte) error {
 	s := strings.Trim(string(b), "\"")
-	*d = panicParseDate(s)
-	return
+	res, err := parseDate(s)
+	if err != nil {
+		return err
+	}
+	*d = res
+	return nil
 }
 
-// MarshalJSON converts DailyRawResponse to json string
+// MarshalJSON converts Date to json string, zero Date is encoded as null
 func (d Date) MarshalJSON() ([]byte, error) {
-	return []byte(d.String()), nil
+	if d.IsZero() {
+		return []byte("null"), nil
+	}
+	return []byte(`"` + d.String() + `"`), nil
 }
 
-// String converts Money to string
+// IsZero reports whether the date is absent
+func (d Date) IsZero() bool {
+	return time.Time(d).IsZero()
+}
+
+// Valid reports whether the date is present
+func (d Date) Valid() bool {
+	return !d.IsZero()
+}
+
+// String converts Date to string, zero Date is converted to empty string
 func (d Date) String() string {
+	if d.IsZero() {
+		return ""
+	}
 	return (time.Time(d)).Format(dateLayout)
 }
@@ -0,0 +1,71 @@
+package alphavantage
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDateParseAbsent(t *testing.T) {
+	testCases := []string{"None", "-", "", "null"}
+
+	for _, input := range testCases {
+		actualResult, err := parseDate(input)
+		require.NoError(t, err, input)
+		assert.True(t, actualResult.IsZero(), input)
+		assert.False(t, actualResult.Valid(), input)
+		assert.Equal(t, "", actualResult.String(), input)
+	}
+}
+
+func TestDateJSON(t *testing.T) {
+	testCases := map[string]string{
+		`"2020-09-10"`: `"2020-09-10"`,
+		`"None"`:       `null`,
+		`"-"`:          `null`,
+		`""`:           `null`,
+		`null`:         `null`,
+	}
+
+	for input, expectedResult := range testCases {
+		var d Date
+		require.NoError(t, json.Unmarshal([]byte(input), &d), input)
+		actualResult, err := json.Marshal(d)
+		require.NoError(t, err)
+		assert.Equal(t, expectedResult, string(actualResult), input)
+
+		var roundTrip Date
+		require.NoError(t, json.Unmarshal(actualResult, &roundTrip))
+		assert.Equal(t, d, roundTrip, input)
+	}
+
+	d := Date(time.Date(2020, 9, 10, 0, 0, 0, 0, time.UTC))
+	assert.True(t, d.Valid())
+	assert.False(t, d.IsZero())
+}
+
+func TestCompanyProfileAbsentDates(t *testing.T) {
+	httpClient := &fakeHTTPClient{
+		StatusCode: http.StatusOK,
+		Result: []byte(`{
+			"Symbol": "AMZN",
+			"LatestQuarter": "2020-06-30",
+			"DividendDate": "None",
+			"ExDividendDate": "None",
+			"LastSplitFactor": "None",
+			"LastSplitDate": "None"
+		}`),
+	}
+
+	data, err := CompanyProfile(context.TODO(), httpClient, "demo", "AMZN")
+	require.NoError(t, err)
+	assert.True(t, data.DividendDate.IsZero())
+	assert.True(t, data.ExDividendDate.IsZero())
+	assert.True(t, data.LastSplitDate.IsZero())
+	assert.Equal(t, "2020-06-30", data.LatestQuarter.String())
+}
@@ -0,0 +1,232 @@
+package alphavantage
+
+import (
+	"math"
+	"math/big"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// maxDecimalExponent limits exponent of parsed decimals, so that "1E999999999" does not allocate gigabytes
+const maxDecimalExponent = 10000
+
+var decimalRegexp = regexp.MustCompile(`^([+-]?)([0-9]*)(?:\.([0-9]*))?(?:[eE]([+-]?[0-9]+))?$`)
+
+var bigTen = big.NewInt(10)
+
+// Decimal arbitrary-precision decimal number, the zero value is 0
+type Decimal struct {
+	// value is coef * 10^exp, nil coef means 0
+	coef *big.Int
+	exp  int32
+}
+
+// NewDecimal creates Decimal equal to coef * 10^exp
+func NewDecimal(coef int64, exp int32) Decimal {
+	return Decimal{coef: big.NewInt(coef), exp: exp}
+}
+
+// ParseDecimal parses plain and scientific notation keeping all the digits, e.g. "0.05251" or "1.23456E-5"
+func ParseDecimal(v string) (Decimal, error) {
+	parts := decimalRegexp.FindStringSubmatch(strings.TrimSpace(v))
+	if parts == nil || parts[2]+parts[3] == "" {
+		return Decimal{}, errors.Errorf("Cannot parse decimal '%s'", v)
+	}
+	sign, integer, fraction, exponent := parts[1], parts[2], parts[3], parts[4]
+
+	exp := -int64(len(fraction))
+	if exponent != "" {
+		e, err := strconv.ParseInt(exponent, 10, 32)
+		if err != nil {
+			return Decimal{}, errors.Wrapf(err, "Cannot parse decimal '%s'", v)
+		}
+		exp += e
+	}
+	if exp > maxDecimalExponent || exp < -maxDecimalExponent {
+		return Decimal{}, errors.Errorf("Cannot parse decimal '%s': exponent is out of range", v)
+	}
+
+	coef, ok := new(big.Int).SetString(sign+integer+fraction, 10)
+	if !ok {
+		return Decimal{}, errors.Errorf("Cannot parse decimal '%s'", v)
+	}
+	return Decimal{coef: coef, exp: int32(exp)}, nil
+}
+
+// coefficient returns non-nil coefficient
+func (d Decimal) coefficient() *big.Int {
+	if d.coef == nil {
+		return new(big.Int)
+	}
+	return d.coef
+}
+
+// String converts Decimal to plain notation string keeping all the parsed digits
+func (d Decimal) String() string {
+	coef := d.coefficient()
+	if d.exp >= 0 {
+		if coef.Sign() == 0 {
+			return "0"
+		}
+		return coef.String() + strings.Repeat("0", int(d.exp))
+	}
+
+	digits := new(big.Int).Abs(coef).String()
+	scale := int(-d.exp)
+	if len(digits) <= scale {
+		digits = strings.Repeat("0", scale-len(digits)+1) + digits
+	}
+	res := digits[:len(digits)-scale] + "." + digits[len(digits)-scale:]
+	if coef.Sign() < 0 {
+		res = "-" + res
+	}
+	return res
+}
+
+// Sign returns -1, 0 or +1
+func (d Decimal) Sign() int {
+	return d.coefficient().Sign()
+}
+
+// IsZero reports whether d is 0
+func (d Decimal) IsZero() bool {
+	return d.Sign() == 0
+}
+
+// Cmp compares d and o numerically, returns -1, 0 or +1
+func (d Decimal) Cmp(o Decimal) int {
+	a, b := d.coefficient(), o.coefficient()
+	switch {
+	case d.exp > o.exp:
+		a = scaleUp(a, int(d.exp-o.exp))
+	case o.exp > d.exp:
+		b = scaleUp(b, int(o.exp-d.exp))
+	}
+	return a.Cmp(b)
+}
+
+// Equal reports whether d and o are numerically equal, e.g. "1.5" and "1.50"
+func (d Decimal) Equal(o Decimal) bool {
+	return d.Cmp(o) == 0
+}
+
+// Rat converts Decimal to big.Rat exactly
+func (d Decimal) Rat() *big.Rat {
+	coef := d.coefficient()
+	if d.exp >= 0 {
+		return new(big.Rat).SetInt(scaleUp(coef, int(d.exp)))
+	}
+	return new(big.Rat).SetFrac(coef, scaleUp(big.NewInt(1), int(-d.exp)))
+}
+
+// Float64 returns the nearest float64 value
+func (d Decimal) Float64() float64 {
+	res, _ := d.Rat().Float64()
+	return res
+}
+
+// Money converts Decimal to Money, fails if the value has more than 4 decimal places or does not fit
+func (d Decimal) Money() (Money, error) {
+	scaled := new(big.Rat).Mul(d.Rat(), new(big.Rat).SetInt64(moneyScale))
+	if !scaled.IsInt() {
+		return 0, errors.Errorf("Cannot convert '%s' to money: more than 4 decimal places", d)
+	}
+	if !scaled.Num().IsInt64() {
+		return 0, errors.Errorf("Cannot convert '%s' to money: out of range", d)
+	}
+	return Money(scaled.Num().Int64()), nil
+}
+
+// Decimal converts Money to Decimal
+func (m Money) Decimal() Decimal {
+	return NewDecimal(int64(m), -4)
+}
+
+// UnmarshalJSON decodes numbers and quoted numbers
+func (d *Decimal) UnmarshalJSON(b []byte) error {
+	res, err := ParseDecimal(strings.Trim(string(b), "\""))
+	if err != nil {
+		return err
+	}
+	*d = res
+	return nil
+}
+
+// MarshalJSON converts Decimal to json string
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return []byte(`"` + d.String() + `"`), nil
+}
+
+func scaleUp(v *big.Int, digits int) *big.Int {
+	if digits == 0 {
+		return v
+	}
+	multiplier := new(big.Int).Exp(bigTen, big.NewInt(int64(digits)), nil)
+	return new(big.Int).Mul(v, multiplier)
+}
+
+// NullDecimal Decimal which may be absent, e.g. "None" in alphavantage responses
+type NullDecimal struct {
+	Decimal Decimal
+	// Valid is false when the value is absent
+	Valid bool
+}
+
+// NewNullDecimal creates valid NullDecimal
+func NewNullDecimal(v Decimal) NullDecimal {
+	return NullDecimal{Decimal: v, Valid: true}
+}
+
+// parseNullDecimal parses decimals, "None", "-" and empty values are parsed as absent
+func parseNullDecimal(v string) (NullDecimal, error) {
+	if isAbsentValue(v) {
+		return NullDecimal{}, nil
+	}
+	res, err := ParseDecimal(v)
+	if err != nil {
+		return NullDecimal{}, err
+	}
+	return NewNullDecimal(res), nil
+}
+
+// ValueOrZero returns the value or 0 when absent
+func (n NullDecimal) ValueOrZero() Decimal {
+	return n.Decimal
+}
+
+// Float64 returns the nearest float64 value or NaN when absent
+func (n NullDecimal) Float64() float64 {
+	if !n.Valid {
+		return math.NaN()
+	}
+	return n.Decimal.Float64()
+}
+
+// String converts NullDecimal to string, absent value is converted to "None"
+func (n NullDecimal) String() string {
+	if !n.Valid {
+		return "None"
+	}
+	return n.Decimal.String()
+}
+
+// UnmarshalJSON decodes numbers, quoted numbers and absent values
+func (n *NullDecimal) UnmarshalJSON(b []byte) error {
+	res, err := parseNullDecimal(strings.Trim(string(b), "\""))
+	if err != nil {
+		return err
+	}
+	*n = res
+	return nil
+}
+
+// MarshalJSON converts NullDecimal to json string, absent value is encoded as null
+func (n NullDecimal) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return n.Decimal.MarshalJSON()
+}
@@ -0,0 +1,134 @@
+package alphavantage
+
+import (
+	"encoding/json"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecimalParse(t *testing.T) {
+	testCases := map[string]string{
+		"0":          "0",
+		"0.0":        "0.0",
+		".42":        "0.42",
+		"-0.12":      "-0.12",
+		"+3":         "3",
+		"0.05251":    "0.05251",
+		"14.0782":    "14.0782",
+		"1.23456E-5": "0.0000123456",
+		"-1.5e3":     "-1500",
+		"2E+2":       "200",
+		"5.":         "5",
+		"123456789012345678901234567890.123456789": "123456789012345678901234567890.123456789",
+	}
+
+	for input, expectedResult := range testCases {
+		actualResult, err := ParseDecimal(input)
+		require.NoError(t, err, input)
+		assert.Equal(t, expectedResult, actualResult.String(), input)
+	}
+}
+
+func TestDecimalParseError(t *testing.T) {
+	testCases := []string{
+		"",
+		"None",
+		".",
+		"-",
+		"1.2.3",
+		"1e",
+		"fourty two",
+		"1E999999",
+	}
+
+	for _, input := range testCases {
+		_, err := ParseDecimal(input)
+		assert.Error(t, err, input)
+	}
+}
+
+func TestDecimalCmp(t *testing.T) {
+	parse := func(v string) Decimal {
+		res, err := ParseDecimal(v)
+		require.NoError(t, err)
+		return res
+	}
+
+	assert.Equal(t, 0, parse("1.5").Cmp(parse("1.50")))
+	assert.True(t, parse("1.5").Equal(parse("15E-1")))
+	assert.Equal(t, -1, parse("0.05251").Cmp(parse("0.0526")))
+	assert.Equal(t, 1, parse("100").Cmp(parse("99.9999")))
+	assert.Equal(t, -1, parse("-2").Cmp(Decimal{}))
+	assert.True(t, Decimal{}.IsZero())
+	assert.Equal(t, "0", Decimal{}.String())
+	assert.Equal(t, 1, parse("1e-10").Sign())
+}
+
+func TestDecimalConversions(t *testing.T) {
+	d, err := ParseDecimal("1.23456E-5")
+	require.NoError(t, err)
+	assert.Equal(t, 0, big.NewRat(123456, 10000000000).Cmp(d.Rat()))
+	assert.Equal(t, 1.23456e-5, d.Float64())
+
+	d, err = ParseDecimal("3e2")
+	require.NoError(t, err)
+	assert.Equal(t, 0, big.NewRat(300, 1).Cmp(d.Rat()))
+}
+
+func TestDecimalMoney(t *testing.T) {
+	d, err := ParseDecimal("124.3953")
+	require.NoError(t, err)
+	m, err := d.Money()
+	require.NoError(t, err)
+	assert.Equal(t, Money(1243953), m)
+	assert.True(t, d.Equal(m.Decimal()))
+	assert.Equal(t, "-1.0000", Money(-10000).Decimal().String())
+
+	d, err = ParseDecimal("0.05251")
+	require.NoError(t, err)
+	_, err = d.Money()
+	assert.Error(t, err)
+
+	d, err = ParseDecimal("1e20")
+	require.NoError(t, err)
+	_, err = d.Money()
+	assert.Error(t, err)
+}
+
+func TestDecimalJSON(t *testing.T) {
+	var d Decimal
+	require.NoError(t, json.Unmarshal([]byte(`"0.05251"`), &d))
+	encoded, err := json.Marshal(d)
+	require.NoError(t, err)
+	assert.Equal(t, `"0.05251"`, string(encoded))
+
+	require.NoError(t, json.Unmarshal([]byte(`-0.458`), &d))
+	assert.Equal(t, "-0.458", d.String())
+
+	assert.Error(t, json.Unmarshal([]byte(`"None"`), &d))
+}
+
+func TestNullDecimalJSON(t *testing.T) {
+	testCases := map[string]string{
+		`"1.23456E-5"`: `"0.0000123456"`,
+		`"None"`:       `null`,
+		`"-"`:          `null`,
+		`null`:         `null`,
+	}
+
+	for input, expectedResult := range testCases {
+		var n NullDecimal
+		require.NoError(t, json.Unmarshal([]byte(input), &n), input)
+		actualResult, err := json.Marshal(n)
+		require.NoError(t, err)
+		assert.Equal(t, expectedResult, string(actualResult), input)
+	}
+
+	assert.True(t, math.IsNaN(NullDecimal{}.Float64()))
+	assert.True(t, NullDecimal{}.ValueOrZero().IsZero())
+	assert.Equal(t, "None", NullDecimal{}.String())
+}
@@ -0,0 +1,143 @@
+package alphavantage
+
+import (
+	"context"
+
+	"github.com/mkorenkov/alphavantage/formtype"
+	"github.com/pkg/errors"
+)
+
+// EarningsHistory annual and quarterly earnings per share of a company
+type EarningsHistory struct {
+	Symbol    string              `json:"symbol"`
+	Annual    []AnnualEarnings    `json:"annualEarnings"`
+	Quarterly []QuarterlyEarnings `json:"quarterlyEarnings"`
+}
+
+// AnnualEarnings reported EPS of a fiscal year
+type AnnualEarnings struct {
+	FiscalDateEnding Date        `json:"fiscalDateEnding"`
+	ReportedEPS      NullDecimal `json:"reportedEPS"`
+}
+
+// QuarterlyEarnings reported and estimated EPS of a fiscal quarter
+type QuarterlyEarnings struct {
+	FiscalDateEnding Date        `json:"fiscalDateEnding"`
+	ReportedDate     Date        `json:"reportedDate"`
+	ReportedEPS      NullDecimal `json:"reportedEPS"`
+	EstimatedEPS     NullDecimal `json:"estimatedEPS"`
+	Surprise         NullDecimal `json:"surprise"`
+	// SurprisePercentage is given in percent
+	SurprisePercentage NullDecimal `json:"surprisePercentage"`
+	// ReportTime is "pre-market" or "post-market"
+	ReportTime string `json:"reportTime"`
+}
+
+// QuarterlyEarningsStatement quarterly earnings and the income statement of the same fiscal quarter
+type QuarterlyEarningsStatement struct {
+	Earnings        QuarterlyEarnings `json:"earnings"`
+	IncomeStatement IncomeStatement   `json:"incomeStatement"`
+}
+
+// Earnings makes API request and returns parsed response
+func (c *Client) Earnings(ctx context.Context, symbol string) (EarningsHistory, error) {
+	response := rawEarningsResponse{}
+	if err := c.request(ctx, "EARNINGS", symbolParams(symbol), &response); err != nil {
+		return EarningsHistory{}, errors.Wrap(err, "Earnings error")
+	}
+	res := EarningsHistory{
+		Symbol:    response.Symbol,
+		Annual:    make([]AnnualEarnings, 0, len(response.AnnualEarnings)),
+		Quarterly: make([]QuarterlyEarnings, 0, len(response.QuarterlyEarnings)),
+	}
+	var parseErrs ParseErrors
+	for _, raw := range response.AnnualEarnings {
+		e, err := fromAnnualEarnings(raw, c.collectParseErrors)
+		if err != nil {
+			if parseErrs.collect(err) {
+				continue
+			}
+			return EarningsHistory{}, errors.Wrap(err, "Earnings parsing error")
+		}
+		res.Annual = append(res.Annual, e)
+	}
+	for _, raw := range response.QuarterlyEarnings {
+		e, err := fromQuarterlyEarnings(raw, c.collectParseErrors)
+		if err != nil {
+			if parseErrs.collect(err) {
+				continue
+			}
+			return EarningsHistory{}, errors.Wrap(err, "Earnings parsing error")
+		}
+		res.Quarterly = append(res.Quarterly, e)
+	}
+	if len(parseErrs) > 0 {
+		return EarningsHistory{}, errors.Wrap(parseErrs, "Earnings parsing error")
+	}
+	return res, nil
+}
+
+// JoinIncomeStatements pairs quarterly earnings with quarterly income statements by FiscalDateEnding,
+// quarters missing in statements are skipped
+func JoinIncomeStatements(earnings []QuarterlyEarnings, statements []IncomeStatement) []QuarterlyEarningsStatement {
+	// keyed by date string, Date values of the same day differ when built in different locations
+	byDate := make(map[string]IncomeStatement, len(statements))
+	for _, statement := range statements {
+		if statement.FormType == formtype.Form10Q {
+			byDate[statement.FiscalDateEnding.String()] = statement
+		}
+	}
+	res := make([]QuarterlyEarningsStatement, 0, len(earnings))
+	for _, e := range earnings {
+		statement, ok := byDate[e.FiscalDateEnding.String()]
+		if !ok {
+			continue
+		}
+		res = append(res, QuarterlyEarningsStatement{Earnings: e, IncomeStatement: statement})
+	}
+	return res
+}
+
+type rawEarningsResponse struct {
+	Symbol            string                 `json:"symbol"`
+	AnnualEarnings    []rawAnnualEarnings    `json:"annualEarnings"`
+	QuarterlyEarnings []rawQuarterlyEarnings `json:"quarterlyEarnings"`
+}
+
+type rawAnnualEarnings struct {
+	FiscalDateEnding string `json:"fiscalDateEnding"`
+	ReportedEPS      string `json:"reportedEPS"`
+}
+
+type rawQuarterlyEarnings struct {
+	FiscalDateEnding   string `json:"fiscalDateEnding"`
+	ReportedDate       string `json:"reportedDate"`
+	ReportedEPS        string `json:"reportedEPS"`
+	EstimatedEPS       string `json:"estimatedEPS"`
+	Surprise           string `json:"surprise"`
+	SurprisePercentage string `json:"surprisePercentage"`
+	ReportTime         string `json:"reportTime"`
+}
+
+func fromAnnualEarnings(raw rawAnnualEarnings, collectAll bool) (AnnualEarnings, error) {
+	p := newStatementParser("AnnualEarnings", raw.FiscalDateEnding, collectAll)
+	res := AnnualEarnings{
+		FiscalDateEnding: p.requiredDate("fiscalDateEnding", raw.FiscalDateEnding),
+		ReportedEPS:      p.nullDecimal("reportedEPS", raw.ReportedEPS),
+	}
+	return res, p.err()
+}
+
+func fromQuarterlyEarnings(raw rawQuarterlyEarnings, collectAll bool) (QuarterlyEarnings, error) {
+	p := newStatementParser("QuarterlyEarnings", raw.FiscalDateEnding, collectAll)
+	res := QuarterlyEarnings{
+		FiscalDateEnding:   p.requiredDate("fiscalDateEnding", raw.FiscalDateEnding),
+		ReportedDate:       p.date("reportedDate", raw.ReportedDate),
+		ReportedEPS:        p.nullDecimal("reportedEPS", raw.ReportedEPS),
+		EstimatedEPS:       p.nullDecimal("estimatedEPS", raw.EstimatedEPS),
+		Surprise:           p.nullDecimal("surprise", raw.Surprise),
+		SurprisePercentage: p.nullDecimal("surprisePercentage", raw.SurprisePercentage),
+		ReportTime:         raw.ReportTime,
+	}
+	return res, p.err()
+}
@@ -0,0 +1,161 @@
+package alphavantage
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/mkorenkov/alphavantage/formtype"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testEarnings = []byte(`
+{
+	"symbol": "IBM",
+	"annualEarnings": [
+		{"fiscalDateEnding": "2020-06-30", "reportedEPS": "4.51"},
+		{"fiscalDateEnding": "2019-12-31", "reportedEPS": "12.81"}
+	],
+	"quarterlyEarnings": [
+		{
+			"fiscalDateEnding": "2020-06-30",
+			"reportedDate": "2020-07-20",
+			"reportedEPS": "2.18",
+			"estimatedEPS": "2.07",
+			"surprise": "0.11",
+			"surprisePercentage": "5.314",
+			"reportTime": "post-market"
+		},
+		{
+			"fiscalDateEnding": "2020-03-31",
+			"reportedDate": "2020-04-20",
+			"reportedEPS": "1.84",
+			"estimatedEPS": "None",
+			"surprise": "None",
+			"surprisePercentage": "None",
+			"reportTime": "post-market"
+		},
+		{
+			"fiscalDateEnding": "2019-12-31",
+			"reportedDate": "2020-01-21",
+			"reportedEPS": "4.71",
+			"estimatedEPS": "4.69",
+			"surprise": "0.02",
+			"surprisePercentage": "0.4264",
+			"reportTime": "post-market"
+		}
+	]
+}`)
+
+func TestEarnings(t *testing.T) {
+	httpClient := &fakeHTTPClient{StatusCode: http.StatusOK, Result: testEarnings}
+	client := NewClient(WithHTTPClient(httpClient), WithAPIKey("demo"))
+
+	res, err := client.Earnings(context.TODO(), "IBM")
+	require.NoError(t, err)
+	assert.Equal(t, "https://www.alphavantage.co/query?function=EARNINGS&symbol=IBM&apikey=demo", httpClient.Request.URL.String())
+	assert.Equal(t, "IBM", res.Symbol)
+
+	require.Len(t, res.Annual, 2)
+	assert.Equal(t, "2019-12-31", res.Annual[1].FiscalDateEnding.String())
+	assert.Equal(t, "12.81", res.Annual[1].ReportedEPS.String())
+
+	require.Len(t, res.Quarterly, 3)
+	q := res.Quarterly[0]
+	assert.Equal(t, "2020-06-30", q.FiscalDateEnding.String())
+	assert.Equal(t, "2020-07-20", q.ReportedDate.String())
+	assert.Equal(t, "2.18", q.ReportedEPS.String())
+	assert.Equal(t, "2.07", q.EstimatedEPS.String())
+	assert.Equal(t, "0.11", q.Surprise.String())
+	assert.Equal(t, "5.314", q.SurprisePercentage.String())
+	assert.Equal(t, "post-market", q.ReportTime)
+
+	assert.True(t, res.Quarterly[1].ReportedEPS.Valid)
+	assert.False(t, res.Quarterly[1].EstimatedEPS.Valid)
+	assert.False(t, res.Quarterly[1].Surprise.Valid)
+	assert.False(t, res.Quarterly[1].SurprisePercentage.Valid)
+}
+
+func TestEarningsParseError(t *testing.T) {
+	httpClient := &fakeHTTPClient{StatusCode: http.StatusOK, Result: []byte(`
+	{
+		"symbol": "IBM",
+		"quarterlyEarnings": [{"fiscalDateEnding": "2020-06-30", "reportedDate": "2020-07-20", "reportedEPS": "2,18"}]
+	}`)}
+	client := NewClient(WithHTTPClient(httpClient))
+
+	_, err := client.Earnings(context.TODO(), "IBM")
+	require.Error(t, err)
+	var parseErr *ParseError
+	require.True(t, errors.As(err, &parseErr))
+	assert.Equal(t, "QuarterlyEarnings", parseErr.Statement)
+	assert.Equal(t, "2020-06-30", parseErr.FiscalDateEnding)
+	assert.Equal(t, "reportedEPS", parseErr.Field)
+
+	httpClient.Result = []byte(`{"symbol": "IBM", "annualEarnings": [{"fiscalDateEnding": "None", "reportedEPS": "12.81"}]}`)
+	_, err = client.Earnings(context.TODO(), "IBM")
+	require.Error(t, err)
+	require.True(t, errors.As(err, &parseErr))
+	assert.Equal(t, "AnnualEarnings", parseErr.Statement)
+	assert.Equal(t, "fiscalDateEnding", parseErr.Field)
+}
+
+func TestEarningsParseErrorCollection(t *testing.T) {
+	httpClient := &fakeHTTPClient{StatusCode: http.StatusOK, Result: []byte(`
+	{
+		"symbol": "IBM",
+		"annualEarnings": [{"fiscalDateEnding": "2019-12-31", "reportedEPS": "12,81"}],
+		"quarterlyEarnings": [
+			{"fiscalDateEnding": "2020-06-30", "reportedDate": "2020-07-20", "reportedEPS": "2.18"},
+			{"fiscalDateEnding": "2020-03-31", "reportedDate": "20.04.2020", "reportedEPS": "1.84"}
+		]
+	}`)}
+	client := NewClient(WithHTTPClient(httpClient), WithParseErrorCollection())
+
+	_, err := client.Earnings(context.TODO(), "IBM")
+	require.Error(t, err)
+	var parseErrs ParseErrors
+	require.True(t, errors.As(err, &parseErrs))
+	require.Len(t, parseErrs, 2)
+	assert.Equal(t, "AnnualEarnings", parseErrs[0].Statement)
+	assert.Equal(t, "reportedEPS", parseErrs[0].Field)
+	assert.Equal(t, "QuarterlyEarnings", parseErrs[1].Statement)
+	assert.Equal(t, "2020-03-31", parseErrs[1].FiscalDateEnding)
+	assert.Equal(t, "reportedDate", parseErrs[1].Field)
+}
+
+func TestJoinIncomeStatements(t *testing.T) {
+	date := func(v string) Date {
+		res, err := parseDate(v)
+		require.NoError(t, err)
+		return res
+	}
+	earnings := []QuarterlyEarnings{
+		{FiscalDateEnding: date("2020-06-30")},
+		{FiscalDateEnding: date("2020-03-31")},
+		{FiscalDateEnding: date("2019-12-31")},
+	}
+	statements := []IncomeStatement{
+		{FormType: formtype.Form10K, FiscalDateEnding: date("2019-12-31"), TotalRevenue: NewNullInt64(77147000000)},
+		{FormType: formtype.Form10Q, FiscalDateEnding: date("2020-06-30"), TotalRevenue: NewNullInt64(18123000000)},
+		{FormType: formtype.Form10Q, FiscalDateEnding: date("2019-12-31"), TotalRevenue: NewNullInt64(21777000000)},
+	}
+
+	res := JoinIncomeStatements(earnings, statements)
+	require.Len(t, res, 2)
+	assert.Equal(t, "2020-06-30", res[0].Earnings.FiscalDateEnding.String())
+	assert.Equal(t, int64(18123000000), res[0].IncomeStatement.TotalRevenue.Int64)
+	assert.Equal(t, "2019-12-31", res[1].Earnings.FiscalDateEnding.String())
+	assert.Equal(t, formtype.Form10Q, res[1].IncomeStatement.FormType)
+	assert.Equal(t, int64(21777000000), res[1].IncomeStatement.TotalRevenue.Int64)
+
+	// dates built elsewhere, e.g. in local time zone, match by day
+	eastern := time.FixedZone("EDT", -4*60*60)
+	earnings = []QuarterlyEarnings{{FiscalDateEnding: Date(time.Date(2020, 6, 30, 0, 0, 0, 0, eastern))}}
+	res = JoinIncomeStatements(earnings, statements)
+	require.Len(t, res, 1)
+	assert.Equal(t, int64(18123000000), res[0].IncomeStatement.TotalRevenue.Int64)
+}
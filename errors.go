@@ -0,0 +1,123 @@
+package alphavantage
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrRateLimited alphavantage request frequency or daily limit exceeded
+	ErrRateLimited = errors.New("alphavantage rate limit exceeded")
+	// ErrInvalidSymbol alphavantage rejected the API call, usually because of unknown symbol
+	ErrInvalidSymbol = errors.New("alphavantage invalid symbol")
+	// ErrPremiumEndpoint endpoint requires premium alphavantage plan
+	ErrPremiumEndpoint = errors.New("alphavantage premium endpoint")
+	// ErrInvalidAPIKey API key is invalid or missing
+	ErrInvalidAPIKey = errors.New("alphavantage invalid API key")
+	// ErrUnknownAPIMessage alphavantage returned a message which cannot be classified
+	ErrUnknownAPIMessage = errors.New("alphavantage unknown API message")
+)
+
+// APIError soft error returned by alphavantage in HTTP 200 response body
+type APIError struct {
+	// Kind is one of Err* values, use errors.Is to check it
+	Kind error
+	// Field is JSON field carrying the message: "Note", "Information" or "Error Message"
+	Field string
+	// Message is the message returned by alphavantage
+	Message string
+}
+
+// Error implements error
+func (e *APIError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Kind, e.Message)
+}
+
+// Unwrap allows errors.Is(err, ErrRateLimited) and alike
+func (e *APIError) Unwrap() error {
+	return e.Kind
+}
+
+// HTTPError non-200 HTTP response
+type HTTPError struct {
+	StatusCode int
+	// RetryAfter is parsed Retry-After header, zero when absent
+	RetryAfter time.Duration
+}
+
+// Error implements error
+func (e *HTTPError) Error() string {
+	return fmt.Sprintf("Alphavantage HTTP Status %d", e.StatusCode)
+}
+
+// parseRetryAfter parses Retry-After header given either in seconds or as HTTP date
+func parseRetryAfter(v string, now time.Time) time.Duration {
+	if v == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(v); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if t, err := http.ParseTime(v); err == nil && t.After(now) {
+		return t.Sub(now)
+	}
+	return 0
+}
+
+// checkSoftError detects "Note", "Information" and "Error Message" response envelopes
+func checkSoftError(body []byte) error {
+	trimmed := bytes.TrimSpace(body)
+	if len(trimmed) == 0 || trimmed[0] != '{' {
+		return nil
+	}
+	envelope := map[string]json.RawMessage{}
+	if err := json.Unmarshal(trimmed, &envelope); err != nil {
+		return nil
+	}
+
+	for _, field := range []string{"Error Message", "Information", "Note"} {
+		raw, ok := envelope[field]
+		if !ok {
+			continue
+		}
+		var message string
+		if err := json.Unmarshal(raw, &message); err != nil {
+			continue
+		}
+		return &APIError{
+			Kind:    classifySoftError(field, message),
+			Field:   field,
+			Message: message,
+		}
+	}
+	return nil
+}
+
+func classifySoftError(field string, message string) error {
+	lower := strings.ToLower(message)
+	switch {
+	// rate limit messages mention both API key and premium plans, so check them first
+	case strings.Contains(lower, "rate limit") || strings.Contains(lower, "call frequency"):
+		return ErrRateLimited
+	case strings.Contains(lower, "premium endpoint"):
+		return ErrPremiumEndpoint
+	case strings.Contains(lower, "apikey") || strings.Contains(lower, "api key"):
+		return ErrInvalidAPIKey
+	}
+
+	switch field {
+	case "Error Message":
+		return ErrInvalidSymbol
+	case "Note":
+		return ErrRateLimited
+	default:
+		return ErrUnknownAPIMessage
+	}
+}
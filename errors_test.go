@@ -0,0 +1,64 @@
+package alphavantage
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckSoftError(t *testing.T) {
+	testCases := map[string]error{
+		`{"Note": "Thank you for using Alpha Vantage! Our standard API call frequency is 5 calls per minute and 500 calls per day."}`:                                                  ErrRateLimited,
+		`{"Information": "We have detected your API key as demo and our standard API rate limit is 25 requests per day. Please subscribe to any of the premium plans."}`:               ErrRateLimited,
+		`{"Information": "Thank you for using Alpha Vantage! This is a premium endpoint. You may subscribe to any of the premium plans at https://www.alphavantage.co/premium/."}`:     ErrPremiumEndpoint,
+		`{"Error Message": "Invalid API call. Please retry or visit the documentation (https://www.alphavantage.co/documentation/) for OVERVIEW."}`:                                    ErrInvalidSymbol,
+		`{"Error Message": "the parameter apikey is invalid or missing. Please claim your free API key on (https://www.alphavantage.co/support/#api-key)."}`:                           ErrInvalidAPIKey,
+		`{"Information": "The **demo** API key is for demo purposes only. Please claim your free API key at (https://www.alphavantage.co/support/#api-key) to explore our full API."}`: ErrInvalidAPIKey,
+		`{"Information": "Something new"}`: ErrUnknownAPIMessage,
+	}
+
+	for input, expectedKind := range testCases {
+		err := checkSoftError([]byte(input))
+		require.Error(t, err, input)
+		assert.True(t, errors.Is(err, expectedKind), input)
+
+		var apiErr *APIError
+		require.True(t, errors.As(err, &apiErr), input)
+		assert.NotEmpty(t, apiErr.Message)
+		assert.Contains(t, input, apiErr.Message)
+	}
+}
+
+func TestCheckSoftErrorNoError(t *testing.T) {
+	testCases := []string{
+		``,
+		`[]`,
+		`symbol,name`,
+		`{"Symbol": "IBM"}`,
+		`{"feed": [], "information": "lowercase keys are data"}`,
+	}
+
+	for _, input := range testCases {
+		assert.NoError(t, checkSoftError([]byte(input)), input)
+	}
+}
+
+func TestCompanyProfileSoftError(t *testing.T) {
+	httpClient := &fakeHTTPClient{
+		StatusCode: http.StatusOK,
+		Result:     []byte(`{"Note": "Thank you for using Alpha Vantage! Our standard API call frequency is 5 calls per minute and 500 calls per day."}`),
+	}
+
+	_, err := CompanyProfile(context.TODO(), httpClient, "demo", "IBM")
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrRateLimited))
+
+	var apiErr *APIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, "Note", apiErr.Field)
+	assert.Contains(t, err.Error(), "5 calls per minute")
+}
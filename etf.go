@@ -0,0 +1,143 @@
+package alphavantage
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// UnknownSector sector of holdings which cannot be classified
+const UnknownSector = "Unknown"
+
+// ETFProfile key metrics and holdings of an ETF
+type ETFProfile struct {
+	NetAssets         NullDecimal `json:"netAssets"`
+	NetExpenseRatio   NullDecimal `json:"netExpenseRatio"`
+	PortfolioTurnover NullDecimal `json:"portfolioTurnover"`
+	DividendYield     NullDecimal `json:"dividendYield"`
+	InceptionDate     Date        `json:"inceptionDate"`
+	Leveraged         bool        `json:"leveraged"`
+	// Sectors weights, e.g. 0.503 for 50.3%
+	Sectors []SectorWeight `json:"sectors"`
+	// Holdings are the top holdings with their weights
+	Holdings []Holding `json:"holdings"`
+}
+
+// SectorWeight weight of a sector in an ETF
+type SectorWeight struct {
+	Sector string  `json:"sector"`
+	Weight Decimal `json:"weight"`
+}
+
+// Holding weight of a symbol in an ETF
+type Holding struct {
+	Symbol      string  `json:"symbol"`
+	Description string  `json:"description"`
+	Weight      Decimal `json:"weight"`
+}
+
+// ETFProfile makes API request and returns parsed response
+func (c *Client) ETFProfile(ctx context.Context, symbol string) (ETFProfile, error) {
+	response := rawETFProfile{}
+	if err := c.request(ctx, "ETF_PROFILE", symbolParams(symbol), &response); err != nil {
+		return ETFProfile{}, errors.Wrap(err, "ETFProfile error")
+	}
+	res, err := fromETFProfile(symbol, response, c.collectParseErrors)
+	if err != nil {
+		return ETFProfile{}, errors.Wrap(err, "ETFProfile parsing error")
+	}
+	return res, nil
+}
+
+// SectorWeights fund-level sector exposure as reported by ETF_PROFILE, sector names are the ones of ETF_PROFILE,
+// e.g. "INFORMATION TECHNOLOGY"
+func (p ETFProfile) SectorWeights() map[string]float64 {
+	res := make(map[string]float64, len(p.Sectors))
+	for _, sector := range p.Sectors {
+		res[sector.Sector] += sector.Weight.Float64()
+	}
+	return res
+}
+
+// SectorExposure expands top holdings into weights by sector, sectorOf returns sector of a holding symbol
+// or "" when unknown, e.g. a lookup in a local database or a cached CompanyProfile call.
+// Holdings without symbol, e.g. cash, and holdings of unknown sector are reported as UnknownSector.
+func (p ETFProfile) SectorExposure(sectorOf func(symbol string) string) map[string]float64 {
+	res := map[string]float64{}
+	for _, holding := range p.Holdings {
+		sector := ""
+		if !isAbsentValue(etfValue(holding.Symbol)) {
+			sector = sectorOf(holding.Symbol)
+		}
+		if sector == "" {
+			sector = UnknownSector
+		}
+		res[sector] += holding.Weight.Float64()
+	}
+	return res
+}
+
+type rawETFProfile struct {
+	NetAssets         string            `json:"net_assets"`
+	NetExpenseRatio   string            `json:"net_expense_ratio"`
+	PortfolioTurnover string            `json:"portfolio_turnover"`
+	DividendYield     string            `json:"dividend_yield"`
+	InceptionDate     string            `json:"inception_date"`
+	Leveraged         string            `json:"leveraged"`
+	Sectors           []rawSectorWeight `json:"sectors"`
+	Holdings          []rawHolding      `json:"holdings"`
+}
+
+type rawSectorWeight struct {
+	Sector string `json:"sector"`
+	Weight string `json:"weight"`
+}
+
+type rawHolding struct {
+	Symbol      string `json:"symbol"`
+	Description string `json:"description"`
+	Weight      string `json:"weight"`
+}
+
+// etfValue converts "n/a" used by ETF_PROFILE to an absent value
+func etfValue(v string) string {
+	if strings.EqualFold(v, "n/a") {
+		return ""
+	}
+	return v
+}
+
+func fromETFProfile(symbol string, raw rawETFProfile, collectAll bool) (ETFProfile, error) {
+	p := newFieldParser("ETFProfile", symbol, collectAll)
+	res := ETFProfile{
+		NetAssets:         p.nullDecimal("net_assets", etfValue(raw.NetAssets)),
+		NetExpenseRatio:   p.nullDecimal("net_expense_ratio", etfValue(raw.NetExpenseRatio)),
+		PortfolioTurnover: p.nullDecimal("portfolio_turnover", etfValue(raw.PortfolioTurnover)),
+		DividendYield:     p.nullDecimal("dividend_yield", etfValue(raw.DividendYield)),
+		InceptionDate:     p.date("inception_date", etfValue(raw.InceptionDate)),
+		Sectors:           make([]SectorWeight, 0, len(raw.Sectors)),
+		Holdings:          make([]Holding, 0, len(raw.Holdings)),
+	}
+	switch strings.ToUpper(raw.Leveraged) {
+	case "YES":
+		res.Leveraged = true
+	case "NO", "":
+	default:
+		p.fail("leveraged", raw.Leveraged, errors.Errorf("Cannot parse '%s'", raw.Leveraged))
+	}
+	for _, sector := range raw.Sectors {
+		res.Sectors = append(res.Sectors, SectorWeight{
+			Sector: sector.Sector,
+			Weight: p.decimal("sectors."+sector.Sector+".weight", sector.Weight),
+		})
+	}
+	for _, holding := range raw.Holdings {
+		res.Holdings = append(res.Holdings, Holding{
+			Symbol:      holding.Symbol,
+			Description: holding.Description,
+			Weight:      p.decimal("holdings."+holding.Symbol+".weight", holding.Weight),
+		})
+	}
+	return res, p.err()
+}
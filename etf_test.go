@@ -0,0 +1,93 @@
+package alphavantage
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testETFProfile = []byte(`
+{
+	"net_assets": "382000000000",
+	"net_expense_ratio": "0.002",
+	"portfolio_turnover": "n/a",
+	"dividend_yield": "0.0054",
+	"inception_date": "1999-03-10",
+	"leveraged": "NO",
+	"sectors": [
+		{"sector": "INFORMATION TECHNOLOGY", "weight": "0.503"},
+		{"sector": "COMMUNICATION SERVICES", "weight": "0.161"}
+	],
+	"holdings": [
+		{"symbol": "AAPL", "description": "APPLE INC", "weight": "0.0888"},
+		{"symbol": "MSFT", "description": "MICROSOFT CORP", "weight": "0.0828"},
+		{"symbol": "GOOGL", "description": "ALPHABET INC CLASS A", "weight": "0.05"},
+		{"symbol": "n/a", "description": "CASH", "weight": "0.001"}
+	]
+}`)
+
+func TestETFProfile(t *testing.T) {
+	httpClient := &fakeHTTPClient{StatusCode: http.StatusOK, Result: testETFProfile}
+	client := NewClient(WithHTTPClient(httpClient), WithAPIKey("demo"))
+
+	res, err := client.ETFProfile(context.TODO(), "QQQ")
+	require.NoError(t, err)
+	assert.Equal(t, "https://www.alphavantage.co/query?function=ETF_PROFILE&symbol=QQQ&apikey=demo", httpClient.Request.URL.String())
+	assert.Equal(t, "382000000000", res.NetAssets.String())
+	assert.Equal(t, "0.002", res.NetExpenseRatio.String())
+	assert.False(t, res.PortfolioTurnover.Valid)
+	assert.Equal(t, "0.0054", res.DividendYield.String())
+	assert.Equal(t, "1999-03-10", res.InceptionDate.String())
+	assert.False(t, res.Leveraged)
+
+	require.Len(t, res.Sectors, 2)
+	assert.Equal(t, "INFORMATION TECHNOLOGY", res.Sectors[0].Sector)
+	assert.Equal(t, "0.503", res.Sectors[0].Weight.String())
+
+	require.Len(t, res.Holdings, 4)
+	assert.Equal(t, "MSFT", res.Holdings[1].Symbol)
+	assert.Equal(t, "MICROSOFT CORP", res.Holdings[1].Description)
+	assert.Equal(t, "0.0828", res.Holdings[1].Weight.String())
+}
+
+func TestETFProfileParseError(t *testing.T) {
+	httpClient := &fakeHTTPClient{StatusCode: http.StatusOK, Result: []byte(`{"net_assets": "382000000000", "leveraged": "2X"}`)}
+	client := NewClient(WithHTTPClient(httpClient))
+
+	_, err := client.ETFProfile(context.TODO(), "QQQ")
+	require.Error(t, err)
+	var parseErr *ParseError
+	require.True(t, errors.As(err, &parseErr))
+	assert.Equal(t, "ETFProfile", parseErr.Statement)
+	assert.Equal(t, "QQQ", parseErr.Record)
+	assert.Equal(t, "leveraged", parseErr.Field)
+}
+
+func TestETFProfileSectorExposure(t *testing.T) {
+	client := NewClient(WithHTTPClient(&fakeHTTPClient{StatusCode: http.StatusOK, Result: testETFProfile}))
+	profile, err := client.ETFProfile(context.TODO(), "QQQ")
+	require.NoError(t, err)
+
+	sectors := map[string]string{"AAPL": "TECHNOLOGY", "MSFT": "TECHNOLOGY", "GOOGL": ""}
+	var lookups []string
+	res := profile.SectorExposure(func(symbol string) string {
+		lookups = append(lookups, symbol)
+		return sectors[symbol]
+	})
+	assert.Equal(t, []string{"AAPL", "MSFT", "GOOGL"}, lookups, "holdings without symbol are not looked up")
+	require.Len(t, res, 2)
+	assert.InDelta(t, 0.1716, res["TECHNOLOGY"], 1e-9)
+	assert.InDelta(t, 0.051, res[UnknownSector], 1e-9)
+}
+
+func TestETFSectorWeights(t *testing.T) {
+	client := NewClient(WithHTTPClient(&fakeHTTPClient{StatusCode: http.StatusOK, Result: testETFProfile}))
+	profile, err := client.ETFProfile(context.TODO(), "QQQ")
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]float64{"INFORMATION TECHNOLOGY": 0.503, "COMMUNICATION SERVICES": 0.161}, profile.SectorWeights())
+}
@@ -9,9 +9,13 @@ import (
 
 // CompanyProfile makes API request and returns parsed response
 func CompanyProfile(ctx context.Context, httpClient HTTPClient, apiKey string, symbol string) (CompanyProfileInfo, error) {
-	url := buildURL(apiKey, "OVERVIEW", symbol)
+	return DefaultClient.withCredentials(httpClient, apiKey).CompanyProfile(ctx, symbol)
+}
+
+// CompanyProfile makes API request and returns parsed response
+func (c *Client) CompanyProfile(ctx context.Context, symbol string) (CompanyProfileInfo, error) {
 	res := CompanyProfileInfo{}
-	if err := makeRequest(ctx, httpClient, url, &res); err != nil {
+	if err := c.request(ctx, "OVERVIEW", symbolParams(symbol), &res); err != nil {
 		return res, errors.Wrap(err, "CompanyProfile error")
 	}
 	return res, nil
@@ -19,140 +23,182 @@ func CompanyProfile(ctx context.Context, httpClient HTTPClient, apiKey string, s
 
 // BalanceSheets makes API request and returns parsed response
 func BalanceSheets(ctx context.Context, httpClient HTTPClient, apiKey string, symbol string) ([]BalanceSheetStatement, error) {
-	url := buildURL(apiKey, "BALANCE_SHEET", symbol)
+	return DefaultClient.withCredentials(httpClient, apiKey).BalanceSheets(ctx, symbol)
+}
+
+// BalanceSheets makes API request and returns parsed response
+func (c *Client) BalanceSheets(ctx context.Context, symbol string) ([]BalanceSheetStatement, error) {
 	response := rawBalanceSheetResponse{}
-	if err := makeRequest(ctx, httpClient, url, &response); err != nil {
+	if err := c.request(ctx, "BALANCE_SHEET", symbolParams(symbol), &response); err != nil {
 		return nil, errors.Wrap(err, "BalanceSheets error")
 	}
 	res := make([]BalanceSheetStatement, 0, len(response.AnnualReports)+len(response.QuarterlyReports))
+	var parseErrs ParseErrors
 	for _, raw := range response.AnnualReports {
-		b, err := fromBalanceSheet(raw, formtype.Form10K)
+		b, err := fromBalanceSheet(raw, formtype.Form10K, c.collectParseErrors)
 		if err != nil {
+			if parseErrs.collect(err) {
+				continue
+			}
 			return nil, errors.Wrap(err, "BalanceSheets parsing error")
 		}
 		res = append(res, b)
 	}
 	for _, raw := range response.QuarterlyReports {
-		b, err := fromBalanceSheet(raw, formtype.Form10Q)
+		b, err := fromBalanceSheet(raw, formtype.Form10Q, c.collectParseErrors)
 		if err != nil {
+			if parseErrs.collect(err) {
+				continue
+			}
 			return nil, errors.Wrap(err, "BalanceSheets parsing error")
 		}
 		res = append(res, b)
 	}
+	if len(parseErrs) > 0 {
+		return nil, errors.Wrap(parseErrs, "BalanceSheets parsing error")
+	}
 	return res, nil
 }
 
 // CashFlows makes API request and returns parsed response
 func CashFlows(ctx context.Context, httpClient HTTPClient, apiKey string, symbol string) ([]CashFlowStatement, error) {
-	url := buildURL(apiKey, "CASH_FLOW", symbol)
+	return DefaultClient.withCredentials(httpClient, apiKey).CashFlows(ctx, symbol)
+}
+
+// CashFlows makes API request and returns parsed response
+func (c *Client) CashFlows(ctx context.Context, symbol string) ([]CashFlowStatement, error) {
 	response := rawCashFlowResponse{}
-	if err := makeRequest(ctx, httpClient, url, &response); err != nil {
+	if err := c.request(ctx, "CASH_FLOW", symbolParams(symbol), &response); err != nil {
 		return nil, errors.Wrap(err, "CashFlows error")
 	}
 	res := make([]CashFlowStatement, 0, len(response.AnnualReports)+len(response.QuarterlyReports))
+	var parseErrs ParseErrors
 	for _, raw := range response.AnnualReports {
-		b, err := fromCashFlow(raw, formtype.Form10K)
+		b, err := fromCashFlow(raw, formtype.Form10K, c.collectParseErrors)
 		if err != nil {
+			if parseErrs.collect(err) {
+				continue
+			}
 			return nil, errors.Wrap(err, "CashFlows parsing error")
 		}
 		res = append(res, b)
 	}
 	for _, raw := range response.QuarterlyReports {
-		b, err := fromCashFlow(raw, formtype.Form10Q)
+		b, err := fromCashFlow(raw, formtype.Form10Q, c.collectParseErrors)
 		if err != nil {
+			if parseErrs.collect(err) {
+				continue
+			}
 			return nil, errors.Wrap(err, "CashFlows parsing error")
 		}
 		res = append(res, b)
 	}
+	if len(parseErrs) > 0 {
+		return nil, errors.Wrap(parseErrs, "CashFlows parsing error")
+	}
 	return res, nil
 }
 
 // IncomeStatements makes API request and returns parsed response
 func IncomeStatements(ctx context.Context, httpClient HTTPClient, apiKey string, symbol string) ([]IncomeStatement, error) {
-	url := buildURL(apiKey, "INCOME_STATEMENT", symbol)
+	return DefaultClient.withCredentials(httpClient, apiKey).IncomeStatements(ctx, symbol)
+}
+
+// IncomeStatements makes API request and returns parsed response
+func (c *Client) IncomeStatements(ctx context.Context, symbol string) ([]IncomeStatement, error) {
 	response := rawIncomeStatementResponse{}
-	if err := makeRequest(ctx, httpClient, url, &response); err != nil {
+	if err := c.request(ctx, "INCOME_STATEMENT", symbolParams(symbol), &response); err != nil {
 		return nil, errors.Wrap(err, "IncomeStatements error")
 	}
 	res := make([]IncomeStatement, 0, len(response.AnnualReports)+len(response.QuarterlyReports))
+	var parseErrs ParseErrors
 	for _, raw := range response.AnnualReports {
-		b, err := fromIncomeStatement(raw, formtype.Form10K)
+		b, err := fromIncomeStatement(raw, formtype.Form10K, c.collectParseErrors)
 		if err != nil {
+			if parseErrs.collect(err) {
+				continue
+			}
 			return nil, errors.Wrap(err, "IncomeStatements parsing error")
 		}
 		res = append(res, b)
 	}
 	for _, raw := range response.QuarterlyReports {
-		b, err := fromIncomeStatement(raw, formtype.Form10Q)
+		b, err := fromIncomeStatement(raw, formtype.Form10Q, c.collectParseErrors)
 		if err != nil {
+			if parseErrs.collect(err) {
+				continue
+			}
 			return nil, errors.Wrap(err, "IncomeStatements parsing error")
 		}
 		res = append(res, b)
 	}
+	if len(parseErrs) > 0 {
+		return nil, errors.Wrap(parseErrs, "IncomeStatements parsing error")
+	}
 	return res, nil
 }
 
 // CompanyProfileInfo parsed version of CompanyProfileInfo data received from alphavantage
 type CompanyProfileInfo struct {
-	Symbol                     string `json:"Symbol"`
-	AssetType                  string `json:"AssetType"`
-	Name                       string `json:"Name"`
-	Description                string `json:"Description"`
-	Exchange                   string `json:"Exchange"`
-	Currency                   string `json:"Currency"`
-	Country                    string `json:"Country"`
-	Sector                     string `json:"Sector"`
-	Industry                   string `json:"Industry"`
-	Address                    string `json:"Address"`
-	FullTimeEmployees          int64  `json:"FullTimeEmployees,string"`
-	FiscalYearEnd              string `json:"FiscalYearEnd"`
-	LatestQuarter              Date   `json:"LatestQuarter"`
-	MarketCapitalization       int64  `json:"MarketCapitalization,string"`
-	EBITDA                     int64  `json:"EBITDA,string"`
-	PERatio                    Money  `json:"PERatio"`
-	PEGRatio                   Money  `json:"PEGRatio"`
-	BookValue                  Money  `json:"BookValue"`
-	DividendPerShare           Money  `json:"DividendPerShare"`
-	DividendYield              Money  `json:"DividendYield"`
-	EPS                        Money  `json:"EPS"`
-	RevenuePerShareTTM         Money  `json:"RevenuePerShareTTM"`
-	ProfitMargin               Money  `json:"ProfitMargin"`
-	OperatingMarginTTM         Money  `json:"OperatingMarginTTM"`
-	ReturnOnAssetsTTM          Money  `json:"ReturnOnAssetsTTM"`
-	ReturnOnEquityTTM          Money  `json:"ReturnOnEquityTTM"`
-	RevenueTTM                 int64  `json:"RevenueTTM,string"`
-	GrossProfitTTM             int64  `json:"GrossProfitTTM,string"`
-	DilutedEPSTTM              Money  `json:"DilutedEPSTTM"`
-	QuarterlyEarningsGrowthYOY Money  `json:"QuarterlyEarningsGrowthYOY"`
-	QuarterlyRevenueGrowthYOY  Money  `json:"QuarterlyRevenueGrowthYOY"`
-	AnalystTargetPrice         Money  `json:"AnalystTargetPrice"`
-	TrailingPE                 Money  `json:"TrailingPE"`
-	ForwardPE                  Money  `json:"ForwardPE"`
-	PriceToSalesRatioTTM       Money  `json:"PriceToSalesRatioTTM"`
-	PriceToBookRatio           Money  `json:"PriceToBookRatio"`
-	EVToRevenue                Money  `json:"EVToRevenue"`
-	EVToEBITDA                 Money  `json:"EVToEBITDA"`
-	Beta                       Money  `json:"Beta"`
-	High52Week                 Money  `json:"52WeekHigh"`
-	Low52Week                  Money  `json:"52WeekLow"`
-	SMA50                      Money  `json:"50DayMovingAverage"`
-	SMA200                     Money  `json:"200DayMovingAverage"`
-	SharesOutstanding          int64  `json:"SharesOutstanding,string"`
-	SharesFloat                int64  `json:"SharesFloat,string"`
-	SharesShort                int64  `json:"SharesShort,string"`
-	SharesShortPriorMonth      int64  `json:"SharesShortPriorMonth,string"`
-	ShortRatio                 Money  `json:"ShortRatio"`
-	ShortPercentOutstanding    Money  `json:"ShortPercentOutstanding"`
-	ShortPercentFloat          Money  `json:"ShortPercentFloat"`
-	PercentInsiders            Money  `json:"PercentInsiders"`
-	PercentInstitutions        Money  `json:"PercentInstitutions"`
-	ForwardAnnualDividendRate  Money  `json:"ForwardAnnualDividendRate"`
-	ForwardAnnualDividendYield Money  `json:"ForwardAnnualDividendYield"`
-	PayoutRatio                Money  `json:"PayoutRatio"`
-	DividendDate               Date   `json:"DividendDate"`
-	ExDividendDate             Date   `json:"ExDividendDate"`
-	LastSplitFactor            string `json:"LastSplitFactor"`
-	LastSplitDate              Date   `json:"LastSplitDate"`
+	Symbol                     string      `json:"Symbol"`
+	AssetType                  string      `json:"AssetType"`
+	Name                       string      `json:"Name"`
+	Description                string      `json:"Description"`
+	Exchange                   string      `json:"Exchange"`
+	Currency                   string      `json:"Currency"`
+	Country                    string      `json:"Country"`
+	Sector                     string      `json:"Sector"`
+	Industry                   string      `json:"Industry"`
+	Address                    string      `json:"Address"`
+	FullTimeEmployees          NullInt64   `json:"FullTimeEmployees"`
+	FiscalYearEnd              string      `json:"FiscalYearEnd"`
+	LatestQuarter              Date        `json:"LatestQuarter"`
+	MarketCapitalization       NullInt64   `json:"MarketCapitalization"`
+	EBITDA                     NullInt64   `json:"EBITDA"`
+	PERatio                    NullDecimal `json:"PERatio"`
+	PEGRatio                   NullDecimal `json:"PEGRatio"`
+	BookValue                  NullDecimal `json:"BookValue"`
+	DividendPerShare           NullDecimal `json:"DividendPerShare"`
+	DividendYield              NullDecimal `json:"DividendYield"`
+	EPS                        NullDecimal `json:"EPS"`
+	RevenuePerShareTTM         NullDecimal `json:"RevenuePerShareTTM"`
+	ProfitMargin               NullDecimal `json:"ProfitMargin"`
+	OperatingMarginTTM         NullDecimal `json:"OperatingMarginTTM"`
+	ReturnOnAssetsTTM          NullDecimal `json:"ReturnOnAssetsTTM"`
+	ReturnOnEquityTTM          NullDecimal `json:"ReturnOnEquityTTM"`
+	RevenueTTM                 NullInt64   `json:"RevenueTTM"`
+	GrossProfitTTM             NullInt64   `json:"GrossProfitTTM"`
+	DilutedEPSTTM              NullDecimal `json:"DilutedEPSTTM"`
+	QuarterlyEarningsGrowthYOY NullDecimal `json:"QuarterlyEarningsGrowthYOY"`
+	QuarterlyRevenueGrowthYOY  NullDecimal `json:"QuarterlyRevenueGrowthYOY"`
+	AnalystTargetPrice         NullDecimal `json:"AnalystTargetPrice"`
+	TrailingPE                 NullDecimal `json:"TrailingPE"`
+	ForwardPE                  NullDecimal `json:"ForwardPE"`
+	PriceToSalesRatioTTM       NullDecimal `json:"PriceToSalesRatioTTM"`
+	PriceToBookRatio           NullDecimal `json:"PriceToBookRatio"`
+	EVToRevenue                NullDecimal `json:"EVToRevenue"`
+	EVToEBITDA                 NullDecimal `json:"EVToEBITDA"`
+	Beta                       NullDecimal `json:"Beta"`
+	High52Week                 NullDecimal `json:"52WeekHigh"`
+	Low52Week                  NullDecimal `json:"52WeekLow"`
+	SMA50                      NullDecimal `json:"50DayMovingAverage"`
+	SMA200                     NullDecimal `json:"200DayMovingAverage"`
+	SharesOutstanding          NullInt64   `json:"SharesOutstanding"`
+	SharesFloat                NullInt64   `json:"SharesFloat"`
+	SharesShort                NullInt64   `json:"SharesShort"`
+	SharesShortPriorMonth      NullInt64   `json:"SharesShortPriorMonth"`
+	ShortRatio                 NullDecimal `json:"ShortRatio"`
+	ShortPercentOutstanding    NullDecimal `json:"ShortPercentOutstanding"`
+	ShortPercentFloat          NullDecimal `json:"ShortPercentFloat"`
+	PercentInsiders            NullDecimal `json:"PercentInsiders"`
+	PercentInstitutions        NullDecimal `json:"PercentInstitutions"`
+	ForwardAnnualDividendRate  NullDecimal `json:"ForwardAnnualDividendRate"`
+	ForwardAnnualDividendYield NullDecimal `json:"ForwardAnnualDividendYield"`
+	PayoutRatio                NullDecimal `json:"PayoutRatio"`
+	DividendDate               Date        `json:"DividendDate"`
+	ExDividendDate             Date        `json:"ExDividendDate"`
+	LastSplitFactor            string      `json:"LastSplitFactor"`
+	LastSplitDate              Date        `json:"LastSplitDate"`
 }
 
 type rawBalanceSheetResponse struct {
@@ -232,119 +278,117 @@ type BalanceSheetStatement struct {
 	FormType                        formtype.FormType `json:"formType"`
 	FiscalDateEnding                Date              `json:"fiscalDateEnding"`
 	ReportedCurrency                string            `json:"reportedCurrency"`
-	TotalAssets                     int64             `json:"totalAssets"`
-	IntangibleAssets                int64             `json:"intangibleAssets"`
-	EarningAssets                   int64             `json:"earningAssets"`
-	OtherCurrentAssets              int64             `json:"otherCurrentAssets"`
-	TotalLiabilities                int64             `json:"totalLiabilities"`
-	TotalShareholderEquity          int64             `json:"totalShareholderEquity"`
-	DeferredLongTermLiabilities     int64             `json:"deferredLongTermLiabilities"`
-	OtherCurrentLiabilities         int64             `json:"otherCurrentLiabilities"`
-	CommonStock                     int64             `json:"commonStock"`
-	RetainedEarnings                int64             `json:"retainedEarnings"`
-	OtherLiabilities                int64             `json:"otherLiabilities"`
-	Goodwill                        int64             `json:"goodwill"`
-	OtherAssets                     int64             `json:"otherAssets"`
-	Cash                            int64             `json:"cash"`
-	TotalCurrentLiabilities         int64             `json:"totalCurrentLiabilities"`
-	ShortTermDebt                   int64             `json:"shortTermDebt"`
-	CurrentLongTermDebt             int64             `json:"currentLongTermDebt"`
-	OtherShareholderEquity          int64             `json:"otherShareholderEquity"`
-	PropertyPlantEquipment          int64             `json:"propertyPlantEquipment"`
-	TotalCurrentAssets              int64             `json:"totalCurrentAssets"`
-	LongTermInvestments             int64             `json:"longTermInvestments"`
-	NetTangibleAssets               int64             `json:"netTangibleAssets"`
-	ShortTermInvestments            int64             `json:"shortTermInvestments"`
-	NetReceivables                  int64             `json:"netReceivables"`
-	LongTermDebt                    int64             `json:"longTermDebt"`
-	Inventory                       int64             `json:"inventory"`
-	AccountsPayable                 int64             `json:"accountsPayable"`
-	TotalPermanentEquity            int64             `json:"totalPermanentEquity"`
-	AdditionalPaidInCapital         int64             `json:"additionalPaidInCapital"`
-	CommonStockTotalEquity          int64             `json:"commonStockTotalEquity"`
-	PreferredStockTotalEquity       int64             `json:"preferredStockTotalEquity"`
-	RetainedEarningsTotalEquity     int64             `json:"retainedEarningsTotalEquity"`
-	TreasuryStock                   int64             `json:"treasuryStock"`
-	AccumulatedAmortization         int64             `json:"accumulatedAmortization"`
-	OtherNonCurrrentAssets          int64             `json:"otherNonCurrrentAssets"`
-	DeferredLongTermAssetCharges    int64             `json:"deferredLongTermAssetCharges"`
-	TotalNonCurrentAssets           int64             `json:"totalNonCurrentAssets"`
-	CapitalLeaseObligations         int64             `json:"capitalLeaseObligations"`
-	TotalLongTermDebt               int64             `json:"totalLongTermDebt"`
-	OtherNonCurrentLiabilities      int64             `json:"otherNonCurrentLiabilities"`
-	TotalNonCurrentLiabilities      int64             `json:"totalNonCurrentLiabilities"`
-	NegativeGoodwill                int64             `json:"negativeGoodwill"`
-	Warrants                        int64             `json:"warrants"`
-	PreferredStockRedeemable        int64             `json:"preferredStockRedeemable"`
-	CapitalSurplus                  int64             `json:"capitalSurplus"`
-	LiabilitiesAndShareholderEquity int64             `json:"liabilitiesAndShareholderEquity"`
-	CashAndShortTermInvestments     int64             `json:"cashAndShortTermInvestments"`
-	AccumulatedDepreciation         int64             `json:"accumulatedDepreciation"`
-	CommonStockSharesOutstanding    int64             `json:"commonStockSharesOutstanding"`
+	TotalAssets                     NullInt64         `json:"totalAssets"`
+	IntangibleAssets                NullInt64         `json:"intangibleAssets"`
+	EarningAssets                   NullInt64         `json:"earningAssets"`
+	OtherCurrentAssets              NullInt64         `json:"otherCurrentAssets"`
+	TotalLiabilities                NullInt64         `json:"totalLiabilities"`
+	TotalShareholderEquity          NullInt64         `json:"totalShareholderEquity"`
+	DeferredLongTermLiabilities     NullInt64         `json:"deferredLongTermLiabilities"`
+	OtherCurrentLiabilities         NullInt64         `json:"otherCurrentLiabilities"`
+	CommonStock                     NullInt64         `json:"commonStock"`
+	RetainedEarnings                NullInt64         `json:"retainedEarnings"`
+	OtherLiabilities                NullInt64         `json:"otherLiabilities"`
+	Goodwill                        NullInt64         `json:"goodwill"`
+	OtherAssets                     NullInt64         `json:"otherAssets"`
+	Cash                            NullInt64         `json:"cash"`
+	TotalCurrentLiabilities         NullInt64         `json:"totalCurrentLiabilities"`
+	ShortTermDebt                   NullInt64         `json:"shortTermDebt"`
+	CurrentLongTermDebt             NullInt64         `json:"currentLongTermDebt"`
+	OtherShareholderEquity          NullInt64         `json:"otherShareholderEquity"`
+	PropertyPlantEquipment          NullInt64         `json:"propertyPlantEquipment"`
+	TotalCurrentAssets              NullInt64         `json:"totalCurrentAssets"`
+	LongTermInvestments             NullInt64         `json:"longTermInvestments"`
+	NetTangibleAssets               NullInt64         `json:"netTangibleAssets"`
+	ShortTermInvestments            NullInt64         `json:"shortTermInvestments"`
+	NetReceivables                  NullInt64         `json:"netReceivables"`
+	LongTermDebt                    NullInt64         `json:"longTermDebt"`
+	Inventory                       NullInt64         `json:"inventory"`
+	AccountsPayable                 NullInt64         `json:"accountsPayable"`
+	TotalPermanentEquity            NullInt64         `json:"totalPermanentEquity"`
+	AdditionalPaidInCapital         NullInt64         `json:"additionalPaidInCapital"`
+	CommonStockTotalEquity          NullInt64         `json:"commonStockTotalEquity"`
+	PreferredStockTotalEquity       NullInt64         `json:"preferredStockTotalEquity"`
+	RetainedEarningsTotalEquity     NullInt64         `json:"retainedEarningsTotalEquity"`
+	TreasuryStock                   NullInt64         `json:"treasuryStock"`
+	AccumulatedAmortization         NullInt64         `json:"accumulatedAmortization"`
+	OtherNonCurrrentAssets          NullInt64         `json:"otherNonCurrrentAssets"`
+	DeferredLongTermAssetCharges    NullInt64         `json:"deferredLongTermAssetCharges"`
+	TotalNonCurrentAssets           NullInt64         `json:"totalNonCurrentAssets"`
+	CapitalLeaseObligations         NullInt64         `json:"capitalLeaseObligations"`
+	TotalLongTermDebt               NullInt64         `json:"totalLongTermDebt"`
+	OtherNonCurrentLiabilities      NullInt64         `json:"otherNonCurrentLiabilities"`
+	TotalNonCurrentLiabilities      NullInt64         `json:"totalNonCurrentLiabilities"`
+	NegativeGoodwill                NullInt64         `json:"negativeGoodwill"`
+	Warrants                        NullInt64         `json:"warrants"`
+	PreferredStockRedeemable        NullInt64         `json:"preferredStockRedeemable"`
+	CapitalSurplus                  NullInt64         `json:"capitalSurplus"`
+	LiabilitiesAndShareholderEquity NullInt64         `json:"liabilitiesAndShareholderEquity"`
+	CashAndShortTermInvestments     NullInt64         `json:"cashAndShortTermInvestments"`
+	AccumulatedDepreciation         NullInt64         `json:"accumulatedDepreciation"`
+	CommonStockSharesOutstanding    NullInt64         `json:"commonStockSharesOutstanding"`
 }
 
-func fromBalanceSheet(balanceSheet rawBalanceSheetItem, formType formtype.FormType) (res BalanceSheetStatement, err error) {
-	defer func() {
-		if r := recover(); r != nil {
-			res = BalanceSheetStatement{}
-			err = r.(error)
-		}
-	}()
-
-	return BalanceSheetStatement{
+func fromBalanceSheet(balanceSheet rawBalanceSheetItem, formType formtype.FormType, collectAll bool) (BalanceSheetStatement, error) {
+	p := newStatementParser("BalanceSheet", balanceSheet.FiscalDateEnding, collectAll)
+	res := BalanceSheetStatement{
 		FormType:                        formType,
-		FiscalDateEnding:                panicParseDate(balanceSheet.FiscalDateEnding),
+		FiscalDateEnding:                p.requiredDate("fiscalDateEnding", balanceSheet.FiscalDateEnding),
 		ReportedCurrency:                balanceSheet.ReportedCurrency,
-		TotalAssets:                     panicParseInt64ish(balanceSheet.TotalAssets),
-		IntangibleAssets:                panicParseInt64ish(balanceSheet.IntangibleAssets),
-		EarningAssets:                   panicParseInt64ish(balanceSheet.EarningAssets),
-		OtherCurrentAssets:              panicParseInt64ish(balanceSheet.OtherCurrentAssets),
-		TotalLiabilities:                panicParseInt64ish(balanceSheet.TotalLiabilities),
-		TotalShareholderEquity:          panicParseInt64ish(balanceSheet.TotalShareholderEquity),
-		DeferredLongTermLiabilities:     panicParseInt64ish(balanceSheet.DeferredLongTermLiabilities),
-		OtherCurrentLiabilities:         panicParseInt64ish(balanceSheet.OtherCurrentLiabilities),
-		CommonStock:                     panicParseInt64ish(balanceSheet.CommonStock),
-		RetainedEarnings:                panicParseInt64ish(balanceSheet.RetainedEarnings),
-		OtherLiabilities:                panicParseInt64ish(balanceSheet.OtherLiabilities),
-		Goodwill:                        panicParseInt64ish(balanceSheet.Goodwill),
-		OtherAssets:                     panicParseInt64ish(balanceSheet.OtherAssets),
-		Cash:                            panicParseInt64ish(balanceSheet.Cash),
-		TotalCurrentLiabilities:         panicParseInt64ish(balanceSheet.TotalCurrentLiabilities),
-		ShortTermDebt:                   panicParseInt64ish(balanceSheet.ShortTermDebt),
-		CurrentLongTermDebt:             panicParseInt64ish(balanceSheet.CurrentLongTermDebt),
-		OtherShareholderEquity:          panicParseInt64ish(balanceSheet.OtherShareholderEquity),
-		PropertyPlantEquipment:          panicParseInt64ish(balanceSheet.PropertyPlantEquipment),
-		TotalCurrentAssets:              panicParseInt64ish(balanceSheet.TotalCurrentAssets),
-		LongTermInvestments:             panicParseInt64ish(balanceSheet.LongTermInvestments),
-		NetTangibleAssets:               panicParseInt64ish(balanceSheet.NetTangibleAssets),
-		ShortTermInvestments:            panicParseInt64ish(balanceSheet.ShortTermInvestments),
-		NetReceivables:                  panicParseInt64ish(balanceSheet.NetReceivables),
-		LongTermDebt:                    panicParseInt64ish(balanceSheet.LongTermDebt),
-		Inventory:                       panicParseInt64ish(balanceSheet.Inventory),
-		AccountsPayable:                 panicParseInt64ish(balanceSheet.AccountsPayable),
-		TotalPermanentEquity:            panicParseInt64ish(balanceSheet.TotalPermanentEquity),
-		AdditionalPaidInCapital:         panicParseInt64ish(balanceSheet.AdditionalPaidInCapital),
-		CommonStockTotalEquity:          panicParseInt64ish(balanceSheet.CommonStockTotalEquity),
-		PreferredStockTotalEquity:       panicParseInt64ish(balanceSheet.PreferredStockTotalEquity),
-		RetainedEarningsTotalEquity:     panicParseInt64ish(balanceSheet.RetainedEarningsTotalEquity),
-		TreasuryStock:                   panicParseInt64ish(balanceSheet.TreasuryStock),
-		AccumulatedAmortization:         panicParseInt64ish(balanceSheet.AccumulatedAmortization),
-		OtherNonCurrrentAssets:          panicParseInt64ish(balanceSheet.OtherNonCurrrentAssets),
-		DeferredLongTermAssetCharges:    panicParseInt64ish(balanceSheet.DeferredLongTermAssetCharges),
-		TotalNonCurrentAssets:           panicParseInt64ish(balanceSheet.TotalNonCurrentAssets),
-		CapitalLeaseObligations:         panicParseInt64ish(balanceSheet.CapitalLeaseObligations),
-		TotalLongTermDebt:               panicParseInt64ish(balanceSheet.TotalLongTermDebt),
-		OtherNonCurrentLiabilities:      panicParseInt64ish(balanceSheet.OtherNonCurrentLiabilities),
-		TotalNonCurrentLiabilities:      panicParseInt64ish(balanceSheet.TotalNonCurrentLiabilities),
-		NegativeGoodwill:                panicParseInt64ish(balanceSheet.NegativeGoodwill),
-		Warrants:                        panicParseInt64ish(balanceSheet.Warrants),
-		PreferredStockRedeemable:        panicParseInt64ish(balanceSheet.PreferredStockRedeemable),
-		CapitalSurplus:                  panicParseInt64ish(balanceSheet.CapitalSurplus),
-		LiabilitiesAndShareholderEquity: panicParseInt64ish(balanceSheet.LiabilitiesAndShareholderEquity),
-		CashAndShortTermInvestments:     panicParseInt64ish(balanceSheet.CashAndShortTermInvestments),
-		AccumulatedDepreciation:         panicParseInt64ish(balanceSheet.AccumulatedDepreciation),
-		CommonStockSharesOutstanding:    panicParseInt64ish(balanceSheet.CommonStockSharesOutstanding),
-	}, nil
+		TotalAssets:                     p.nullInt64("totalAssets", balanceSheet.TotalAssets),
+		IntangibleAssets:                p.nullInt64("intangibleAssets", balanceSheet.IntangibleAssets),
+		EarningAssets:                   p.nullInt64("earningAssets", balanceSheet.EarningAssets),
+		OtherCurrentAssets:              p.nullInt64("otherCurrentAssets", balanceSheet.OtherCurrentAssets),
+		TotalLiabilities:                p.nullInt64("totalLiabilities", balanceSheet.TotalLiabilities),
+		TotalShareholderEquity:          p.nullInt64("totalShareholderEquity", balanceSheet.TotalShareholderEquity),
+		DeferredLongTermLiabilities:     p.nullInt64("deferredLongTermLiabilities", balanceSheet.DeferredLongTermLiabilities),
+		OtherCurrentLiabilities:         p.nullInt64("otherCurrentLiabilities", balanceSheet.OtherCurrentLiabilities),
+		CommonStock:                     p.nullInt64("commonStock", balanceSheet.CommonStock),
+		RetainedEarnings:                p.nullInt64("retainedEarnings", balanceSheet.RetainedEarnings),
+		OtherLiabilities:                p.nullInt64("otherLiabilities", balanceSheet.OtherLiabilities),
+		Goodwill:                        p.nullInt64("goodwill", balanceSheet.Goodwill),
+		OtherAssets:                     p.nullInt64("otherAssets", balanceSheet.OtherAssets),
+		Cash:                            p.nullInt64("cash", balanceSheet.Cash),
+		TotalCurrentLiabilities:         p.nullInt64("totalCurrentLiabilities", balanceSheet.TotalCurrentLiabilities),
+		ShortTermDebt:                   p.nullInt64("shortTermDebt", balanceSheet.ShortTermDebt),
+		CurrentLongTermDebt:             p.nullInt64("currentLongTermDebt", balanceSheet.CurrentLongTermDebt),
+		OtherShareholderEquity:          p.nullInt64("otherShareholderEquity", balanceSheet.OtherShareholderEquity),
+		PropertyPlantEquipment:          p.nullInt64("propertyPlantEquipment", balanceSheet.PropertyPlantEquipment),
+		TotalCurrentAssets:              p.nullInt64("totalCurrentAssets", balanceSheet.TotalCurrentAssets),
+		LongTermInvestments:             p.nullInt64("longTermInvestments", balanceSheet.LongTermInvestments),
+		NetTangibleAssets:               p.nullInt64("netTangibleAssets", balanceSheet.NetTangibleAssets),
+		ShortTermInvestments:            p.nullInt64("shortTermInvestments", balanceSheet.ShortTermInvestments),
+		NetReceivables:                  p.nullInt64("netReceivables", balanceSheet.NetReceivables),
+		LongTermDebt:                    p.nullInt64("longTermDebt", balanceSheet.LongTermDebt),
+		Inventory:                       p.nullInt64("inventory", balanceSheet.Inventory),
+		AccountsPayable:                 p.nullInt64("accountsPayable", balanceSheet.AccountsPayable),
+		TotalPermanentEquity:            p.nullInt64("totalPermanentEquity", balanceSheet.TotalPermanentEquity),
+		AdditionalPaidInCapital:         p.nullInt64("additionalPaidInCapital", balanceSheet.AdditionalPaidInCapital),
+		CommonStockTotalEquity:          p.nullInt64("commonStockTotalEquity", balanceSheet.CommonStockTotalEquity),
+		PreferredStockTotalEquity:       p.nullInt64("preferredStockTotalEquity", balanceSheet.PreferredStockTotalEquity),
+		RetainedEarningsTotalEquity:     p.nullInt64("retainedEarningsTotalEquity", balanceSheet.RetainedEarningsTotalEquity),
+		TreasuryStock:                   p.nullInt64("treasuryStock", balanceSheet.TreasuryStock),
+		AccumulatedAmortization:         p.nullInt64("accumulatedAmortization", balanceSheet.AccumulatedAmortization),
+		OtherNonCurrrentAssets:          p.nullInt64("otherNonCurrrentAssets", balanceSheet.OtherNonCurrrentAssets),
+		DeferredLongTermAssetCharges:    p.nullInt64("deferredLongTermAssetCharges", balanceSheet.DeferredLongTermAssetCharges),
+		TotalNonCurrentAssets:           p.nullInt64("totalNonCurrentAssets", balanceSheet.TotalNonCurrentAssets),
+		CapitalLeaseObligations:         p.nullInt64("capitalLeaseObligations", balanceSheet.CapitalLeaseObligations),
+		TotalLongTermDebt:               p.nullInt64("totalLongTermDebt", balanceSheet.TotalLongTermDebt),
+		OtherNonCurrentLiabilities:      p.nullInt64("otherNonCurrentLiabilities", balanceSheet.OtherNonCurrentLiabilities),
+		TotalNonCurrentLiabilities:      p.nullInt64("totalNonCurrentLiabilities", balanceSheet.TotalNonCurrentLiabilities),
+		NegativeGoodwill:                p.nullInt64("negativeGoodwill", balanceSheet.NegativeGoodwill),
+		Warrants:                        p.nullInt64("warrants", balanceSheet.Warrants),
+		PreferredStockRedeemable:        p.nullInt64("preferredStockRedeemable", balanceSheet.PreferredStockRedeemable),
+		CapitalSurplus:                  p.nullInt64("capitalSurplus", balanceSheet.CapitalSurplus),
+		LiabilitiesAndShareholderEquity: p.nullInt64("liabilitiesAndShareholderEquity", balanceSheet.LiabilitiesAndShareholderEquity),
+		CashAndShortTermInvestments:     p.nullInt64("cashAndShortTermInvestments", balanceSheet.CashAndShortTermInvestments),
+		AccumulatedDepreciation:         p.nullInt64("accumulatedDepreciation", balanceSheet.AccumulatedDepreciation),
+		CommonStockSharesOutstanding:    p.nullInt64("commonStockSharesOutstanding", balanceSheet.CommonStockSharesOutstanding),
+	}
+	if err := p.err(); err != nil {
+		return BalanceSheetStatement{}, err
+	}
+	return res, nil
 }
 
 type rawCashFlowItem struct {
@@ -379,65 +423,63 @@ type CashFlowStatement struct {
 	FormType                       formtype.FormType `json:"formType"`
 	FiscalDateEnding               Date              `json:"fiscalDateEnding"`
 	ReportedCurrency               string            `json:"reportedCurrency"`
-	Investments                    int64             `json:"investments"`
-	ChangeInLiabilities            int64             `json:"changeInLiabilities"`
-	CashflowFromInvestment         int64             `json:"cashflowFromInvestment"`
-	OtherCashflowFromInvestment    int64             `json:"otherCashflowFromInvestment"`
-	NetBorrowings                  int64             `json:"netBorrowings"`
-	CashflowFromFinancing          int64             `json:"cashflowFromFinancing"`
-	OtherCashflowFromFinancing     int64             `json:"otherCashflowFromFinancing"`
-	ChangeInOperatingActivities    int64             `json:"changeInOperatingActivities"`
-	NetIncome                      int64             `json:"netIncome"`
-	ChangeInCash                   int64             `json:"changeInCash"`
-	OperatingCashflow              int64             `json:"operatingCashflow"`
-	OtherOperatingCashflow         int64             `json:"otherOperatingCashflow"`
-	Depreciation                   int64             `json:"depreciation"`
-	DividendPayout                 int64             `json:"dividendPayout"`
-	StockSaleAndPurchase           int64             `json:"stockSaleAndPurchase"`
-	ChangeInInventory              int64             `json:"changeInInventory"`
-	ChangeInAccountReceivables     int64             `json:"changeInAccountReceivables"`
-	ChangeInNetIncome              int64             `json:"changeInNetIncome"`
-	CapitalExpenditures            int64             `json:"capitalExpenditures"`
-	ChangeInReceivables            int64             `json:"changeInReceivables"`
-	ChangeInExchangeRate           int64             `json:"changeInExchangeRate"`
-	ChangeInCashAndCashEquivalents int64             `json:"changeInCashAndCashEquivalents"`
+	Investments                    NullInt64         `json:"investments"`
+	ChangeInLiabilities            NullInt64         `json:"changeInLiabilities"`
+	CashflowFromInvestment         NullInt64         `json:"cashflowFromInvestment"`
+	OtherCashflowFromInvestment    NullInt64         `json:"otherCashflowFromInvestment"`
+	NetBorrowings                  NullInt64         `json:"netBorrowings"`
+	CashflowFromFinancing          NullInt64         `json:"cashflowFromFinancing"`
+	OtherCashflowFromFinancing     NullInt64         `json:"otherCashflowFromFinancing"`
+	ChangeInOperatingActivities    NullInt64         `json:"changeInOperatingActivities"`
+	NetIncome                      NullInt64         `json:"netIncome"`
+	ChangeInCash                   NullInt64         `json:"changeInCash"`
+	OperatingCashflow              NullInt64         `json:"operatingCashflow"`
+	OtherOperatingCashflow         NullInt64         `json:"otherOperatingCashflow"`
+	Depreciation                   NullInt64         `json:"depreciation"`
+	DividendPayout                 NullInt64         `json:"dividendPayout"`
+	StockSaleAndPurchase           NullInt64         `json:"stockSaleAndPurchase"`
+	ChangeInInventory              NullInt64         `json:"changeInInventory"`
+	ChangeInAccountReceivables     NullInt64         `json:"changeInAccountReceivables"`
+	ChangeInNetIncome              NullInt64         `json:"changeInNetIncome"`
+	CapitalExpenditures            NullInt64         `json:"capitalExpenditures"`
+	ChangeInReceivables            NullInt64         `json:"changeInReceivables"`
+	ChangeInExchangeRate           NullInt64         `json:"changeInExchangeRate"`
+	ChangeInCashAndCashEquivalents NullInt64         `json:"changeInCashAndCashEquivalents"`
 }
 
-func fromCashFlow(cashFlow rawCashFlowItem, formType formtype.FormType) (res CashFlowStatement, err error) {
-	defer func() {
-		if r := recover(); r != nil {
-			res = CashFlowStatement{}
-			err = r.(error)
-		}
-	}()
-
-	return CashFlowStatement{
+func fromCashFlow(cashFlow rawCashFlowItem, formType formtype.FormType, collectAll bool) (CashFlowStatement, error) {
+	p := newStatementParser("CashFlow", cashFlow.FiscalDateEnding, collectAll)
+	res := CashFlowStatement{
 		FormType:                       formType,
-		FiscalDateEnding:               panicParseDate(cashFlow.FiscalDateEnding),
+		FiscalDateEnding:               p.requiredDate("fiscalDateEnding", cashFlow.FiscalDateEnding),
 		ReportedCurrency:               cashFlow.ReportedCurrency,
-		Investments:                    panicParseInt64ish(cashFlow.Investments),
-		ChangeInLiabilities:            panicParseInt64ish(cashFlow.ChangeInLiabilities),
-		CashflowFromInvestment:         panicParseInt64ish(cashFlow.CashflowFromInvestment),
-		OtherCashflowFromInvestment:    panicParseInt64ish(cashFlow.OtherCashflowFromInvestment),
-		NetBorrowings:                  panicParseInt64ish(cashFlow.NetBorrowings),
-		CashflowFromFinancing:          panicParseInt64ish(cashFlow.CashflowFromFinancing),
-		OtherCashflowFromFinancing:     panicParseInt64ish(cashFlow.OtherCashflowFromFinancing),
-		ChangeInOperatingActivities:    panicParseInt64ish(cashFlow.ChangeInOperatingActivities),
-		NetIncome:                      panicParseInt64ish(cashFlow.NetIncome),
-		ChangeInCash:                   panicParseInt64ish(cashFlow.ChangeInCash),
-		OperatingCashflow:              panicParseInt64ish(cashFlow.OperatingCashflow),
-		OtherOperatingCashflow:         panicParseInt64ish(cashFlow.OtherOperatingCashflow),
-		Depreciation:                   panicParseInt64ish(cashFlow.Depreciation),
-		DividendPayout:                 panicParseInt64ish(cashFlow.DividendPayout),
-		StockSaleAndPurchase:           panicParseInt64ish(cashFlow.StockSaleAndPurchase),
-		ChangeInInventory:              panicParseInt64ish(cashFlow.ChangeInInventory),
-		ChangeInAccountReceivables:     panicParseInt64ish(cashFlow.ChangeInAccountReceivables),
-		ChangeInNetIncome:              panicParseInt64ish(cashFlow.ChangeInNetIncome),
-		CapitalExpenditures:            panicParseInt64ish(cashFlow.CapitalExpenditures),
-		ChangeInReceivables:            panicParseInt64ish(cashFlow.ChangeInReceivables),
-		ChangeInExchangeRate:           panicParseInt64ish(cashFlow.ChangeInExchangeRate),
-		ChangeInCashAndCashEquivalents: panicParseInt64ish(cashFlow.ChangeInCashAndCashEquivalents),
-	}, nil
+		Investments:                    p.nullInt64("investments", cashFlow.Investments),
+		ChangeInLiabilities:            p.nullInt64("changeInLiabilities", cashFlow.ChangeInLiabilities),
+		CashflowFromInvestment:         p.nullInt64("cashflowFromInvestment", cashFlow.CashflowFromInvestment),
+		OtherCashflowFromInvestment:    p.nullInt64("otherCashflowFromInvestment", cashFlow.OtherCashflowFromInvestment),
+		NetBorrowings:                  p.nullInt64("netBorrowings", cashFlow.NetBorrowings),
+		CashflowFromFinancing:          p.nullInt64("cashflowFromFinancing", cashFlow.CashflowFromFinancing),
+		OtherCashflowFromFinancing:     p.nullInt64("otherCashflowFromFinancing", cashFlow.OtherCashflowFromFinancing),
+		ChangeInOperatingActivities:    p.nullInt64("changeInOperatingActivities", cashFlow.ChangeInOperatingActivities),
+		NetIncome:                      p.nullInt64("netIncome", cashFlow.NetIncome),
+		ChangeInCash:                   p.nullInt64("changeInCash", cashFlow.ChangeInCash),
+		OperatingCashflow:              p.nullInt64("operatingCashflow", cashFlow.OperatingCashflow),
+		OtherOperatingCashflow:         p.nullInt64("otherOperatingCashflow", cashFlow.OtherOperatingCashflow),
+		Depreciation:                   p.nullInt64("depreciation", cashFlow.Depreciation),
+		DividendPayout:                 p.nullInt64("dividendPayout", cashFlow.DividendPayout),
+		StockSaleAndPurchase:           p.nullInt64("stockSaleAndPurchase", cashFlow.StockSaleAndPurchase),
+		ChangeInInventory:              p.nullInt64("changeInInventory", cashFlow.ChangeInInventory),
+		ChangeInAccountReceivables:     p.nullInt64("changeInAccountReceivables", cashFlow.ChangeInAccountReceivables),
+		ChangeInNetIncome:              p.nullInt64("changeInNetIncome", cashFlow.ChangeInNetIncome),
+		CapitalExpenditures:            p.nullInt64("capitalExpenditures", cashFlow.CapitalExpenditures),
+		ChangeInReceivables:            p.nullInt64("changeInReceivables", cashFlow.ChangeInReceivables),
+		ChangeInExchangeRate:           p.nullInt64("changeInExchangeRate", cashFlow.ChangeInExchangeRate),
+		ChangeInCashAndCashEquivalents: p.nullInt64("changeInCashAndCashEquivalents", cashFlow.ChangeInCashAndCashEquivalents),
+	}
+	if err := p.err(); err != nil {
+		return CashFlowStatement{}, err
+	}
+	return res, nil
 }
 
 type rawIncomeStatementItem struct {
@@ -477,73 +519,71 @@ type IncomeStatement struct {
 	FormType                          formtype.FormType `json:"formType"`
 	FiscalDateEnding                  Date              `json:"fiscalDateEnding"`
 	ReportedCurrency                  string            `json:"reportedCurrency"`
-	TotalRevenue                      int64             `json:"totalRevenue"`
-	TotalOperatingExpense             int64             `json:"totalOperatingExpense"`
-	CostOfRevenue                     int64             `json:"costOfRevenue"`
-	GrossProfit                       int64             `json:"grossProfit"`
-	Ebit                              int64             `json:"ebit"`
-	NetIncome                         int64             `json:"netIncome"`
-	ResearchAndDevelopment            int64             `json:"researchAndDevelopment"`
-	EffectOfAccountingCharges         int64             `json:"effectOfAccountingCharges"`
-	IncomeBeforeTax                   int64             `json:"incomeBeforeTax"`
-	MinorityInterest                  int64             `json:"minorityInterest"`
-	SellingGeneralAdministrative      int64             `json:"sellingGeneralAdministrative"`
-	OtherNonOperatingIncome           int64             `json:"otherNonOperatingIncome"`
-	OperatingIncome                   int64             `json:"operatingIncome"`
-	OtherOperatingExpense             int64             `json:"otherOperatingExpense"`
-	InterestExpense                   int64             `json:"interestExpense"`
-	TaxProvision                      int64             `json:"taxProvision"`
-	InterestIncome                    int64             `json:"interestIncome"`
-	NetInterestIncome                 int64             `json:"netInterestIncome"`
-	ExtraordinaryItems                int64             `json:"extraordinaryItems"`
-	NonRecurring                      int64             `json:"nonRecurring"`
-	OtherItems                        int64             `json:"otherItems"`
-	IncomeTaxExpense                  int64             `json:"incomeTaxExpense"`
-	TotalOtherIncomeExpense           int64             `json:"totalOtherIncomeExpense"`
-	DiscontinuedOperations            int64             `json:"discontinuedOperations"`
-	NetIncomeFromContinuingOperations int64             `json:"netIncomeFromContinuingOperations"`
-	NetIncomeApplicableToCommonShares int64             `json:"netIncomeApplicableToCommonShares"`
-	PreferredStockAndOtherAdjustments int64             `json:"preferredStockAndOtherAdjustments"`
+	TotalRevenue                      NullInt64         `json:"totalRevenue"`
+	TotalOperatingExpense             NullInt64         `json:"totalOperatingExpense"`
+	CostOfRevenue                     NullInt64         `json:"costOfRevenue"`
+	GrossProfit                       NullInt64         `json:"grossProfit"`
+	Ebit                              NullInt64         `json:"ebit"`
+	NetIncome                         NullInt64         `json:"netIncome"`
+	ResearchAndDevelopment            NullInt64         `json:"researchAndDevelopment"`
+	EffectOfAccountingCharges         NullInt64         `json:"effectOfAccountingCharges"`
+	IncomeBeforeTax                   NullInt64         `json:"incomeBeforeTax"`
+	MinorityInterest                  NullInt64         `json:"minorityInterest"`
+	SellingGeneralAdministrative      NullInt64         `json:"sellingGeneralAdministrative"`
+	OtherNonOperatingIncome           NullInt64         `json:"otherNonOperatingIncome"`
+	OperatingIncome                   NullInt64         `json:"operatingIncome"`
+	OtherOperatingExpense             NullInt64         `json:"otherOperatingExpense"`
+	InterestExpense                   NullInt64         `json:"interestExpense"`
+	TaxProvision                      NullInt64         `json:"taxProvision"`
+	InterestIncome                    NullInt64         `json:"interestIncome"`
+	NetInterestIncome                 NullInt64         `json:"netInterestIncome"`
+	ExtraordinaryItems                NullInt64         `json:"extraordinaryItems"`
+	NonRecurring                      NullInt64         `json:"nonRecurring"`
+	OtherItems                        NullInt64         `json:"otherItems"`
+	IncomeTaxExpense                  NullInt64         `json:"incomeTaxExpense"`
+	TotalOtherIncomeExpense           NullInt64         `json:"totalOtherIncomeExpense"`
+	DiscontinuedOperations            NullInt64         `json:"discontinuedOperations"`
+	NetIncomeFromContinuingOperations NullInt64         `json:"netIncomeFromContinuingOperations"`
+	NetIncomeApplicableToCommonShares NullInt64         `json:"netIncomeApplicableToCommonShares"`
+	PreferredStockAndOtherAdjustments NullInt64         `json:"preferredStockAndOtherAdjustments"`
 }
 
-func fromIncomeStatement(income rawIncomeStatementItem, formType formtype.FormType) (res IncomeStatement, err error) {
-	defer func() {
-		if r := recover(); r != nil {
-			res = IncomeStatement{}
-			err = r.(error)
-		}
-	}()
-
-	return IncomeStatement{
+func fromIncomeStatement(income rawIncomeStatementItem, formType formtype.FormType, collectAll bool) (IncomeStatement, error) {
+	p := newStatementParser("IncomeStatement", income.FiscalDateEnding, collectAll)
+	res := IncomeStatement{
 		FormType:                          formType,
-		FiscalDateEnding:                  panicParseDate(income.FiscalDateEnding),
+		FiscalDateEnding:                  p.requiredDate("fiscalDateEnding", income.FiscalDateEnding),
 		ReportedCurrency:                  income.ReportedCurrency,
-		TotalRevenue:                      panicParseInt64ish(income.TotalRevenue),
-		TotalOperatingExpense:             panicParseInt64ish(income.TotalOperatingExpense),
-		CostOfRevenue:                     panicParseInt64ish(income.CostOfRevenue),
-		GrossProfit:                       panicParseInt64ish(income.GrossProfit),
-		Ebit:                              panicParseInt64ish(income.Ebit),
-		NetIncome:                         panicParseInt64ish(income.NetIncome),
-		ResearchAndDevelopment:            panicParseInt64ish(income.ResearchAndDevelopment),
-		EffectOfAccountingCharges:         panicParseInt64ish(income.EffectOfAccountingCharges),
-		IncomeBeforeTax:                   panicParseInt64ish(income.IncomeBeforeTax),
-		MinorityInterest:                  panicParseInt64ish(income.MinorityInterest),
-		SellingGeneralAdministrative:      panicParseInt64ish(income.SellingGeneralAdministrative),
-		OtherNonOperatingIncome:           panicParseInt64ish(income.OtherNonOperatingIncome),
-		OperatingIncome:                   panicParseInt64ish(income.OperatingIncome),
-		OtherOperatingExpense:             panicParseInt64ish(income.OtherOperatingExpense),
-		InterestExpense:                   panicParseInt64ish(income.InterestExpense),
-		TaxProvision:                      panicParseInt64ish(income.TaxProvision),
-		InterestIncome:                    panicParseInt64ish(income.InterestIncome),
-		NetInterestIncome:                 panicParseInt64ish(income.NetInterestIncome),
-		ExtraordinaryItems:                panicParseInt64ish(income.ExtraordinaryItems),
-		NonRecurring:                      panicParseInt64ish(income.NonRecurring),
-		OtherItems:                        panicParseInt64ish(income.OtherItems),
-		IncomeTaxExpense:                  panicParseInt64ish(income.IncomeTaxExpense),
-		TotalOtherIncomeExpense:           panicParseInt64ish(income.TotalOtherIncomeExpense),
-		DiscontinuedOperations:            panicParseInt64ish(income.DiscontinuedOperations),
-		NetIncomeFromContinuingOperations: panicParseInt64ish(income.NetIncomeFromContinuingOperations),
-		NetIncomeApplicableToCommonShares: panicParseInt64ish(income.NetIncomeApplicableToCommonShares),
-		PreferredStockAndOtherAdjustments: panicParseInt64ish(income.PreferredStockAndOtherAdjustments),
-	}, nil
+		TotalRevenue:                      p.nullInt64("totalRevenue", income.TotalRevenue),
+		TotalOperatingExpense:             p.nullInt64("totalOperatingExpense", income.TotalOperatingExpense),
+		CostOfRevenue:                     p.nullInt64("costOfRevenue", income.CostOfRevenue),
+		GrossProfit:                       p.nullInt64("grossProfit", income.GrossProfit),
+		Ebit:                              p.nullInt64("ebit", income.Ebit),
+		NetIncome:                         p.nullInt64("netIncome", income.NetIncome),
+		ResearchAndDevelopment:            p.nullInt64("researchAndDevelopment", income.ResearchAndDevelopment),
+		EffectOfAccountingCharges:         p.nullInt64("effectOfAccountingCharges", income.EffectOfAccountingCharges),
+		IncomeBeforeTax:                   p.nullInt64("incomeBeforeTax", income.IncomeBeforeTax),
+		MinorityInterest:                  p.nullInt64("minorityInterest", income.MinorityInterest),
+		SellingGeneralAdministrative:      p.nullInt64("sellingGeneralAdministrative", income.SellingGeneralAdministrative),
+		OtherNonOperatingIncome:           p.nullInt64("otherNonOperatingIncome", income.OtherNonOperatingIncome),
+		OperatingIncome:                   p.nullInt64("operatingIncome", income.OperatingIncome),
+		OtherOperatingExpense:             p.nullInt64("otherOperatingExpense", income.OtherOperatingExpense),
+		InterestExpense:                   p.nullInt64("interestExpense", income.InterestExpense),
+		TaxProvision:                      p.nullInt64("taxProvision", income.TaxProvision),
+		InterestIncome:                    p.nullInt64("interestIncome", income.InterestIncome),
+		NetInterestIncome:                 p.nullInt64("netInterestIncome", income.NetInterestIncome),
+		ExtraordinaryItems:                p.nullInt64("extraordinaryItems", income.ExtraordinaryItems),
+		NonRecurring:                      p.nullInt64("nonRecurring", income.NonRecurring),
+		OtherItems:                        p.nullInt64("otherItems", income.OtherItems),
+		IncomeTaxExpense:                  p.nullInt64("incomeTaxExpense", income.IncomeTaxExpense),
+		TotalOtherIncomeExpense:           p.nullInt64("totalOtherIncomeExpense", income.TotalOtherIncomeExpense),
+		DiscontinuedOperations:            p.nullInt64("discontinuedOperations", income.DiscontinuedOperations),
+		NetIncomeFromContinuingOperations: p.nullInt64("netIncomeFromContinuingOperations", income.NetIncomeFromContinuingOperations),
+		NetIncomeApplicableToCommonShares: p.nullInt64("netIncomeApplicableToCommonShares", income.NetIncomeApplicableToCommonShares),
+		PreferredStockAndOtherAdjustments: p.nullInt64("preferredStockAndOtherAdjustments", income.PreferredStockAndOtherAdjustments),
+	}
+	if err := p.err(); err != nil {
+		return IncomeStatement{}, err
+	}
+	return res, nil
 }
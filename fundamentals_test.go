@@ -96,19 +96,11 @@ func TestIBMProfileParse(t *testing.T) {
 
 		varType := parsedResponseElements.Type().Field(i).Type
 		switch varType {
-		case reflect.TypeOf(Money(0)):
-			expectedParts := strings.Split(expected, ".")
-			require.Equal(t, 2, len(expectedParts), varName)
-
-			actualParts := strings.Split(parsedResponseElements.Field(i).Interface().(Money).String(), ".")
-			require.Equal(t, 2, len(actualParts), varName)
-
-			assert.Equal(t, expectedParts[0], actualParts[0])
-			for index, value := range expectedParts[1] {
-				assert.Equal(t, string(value), string(actualParts[1][index]), varName)
-			}
-		case reflect.TypeOf(int64(0)):
-			currentResult := strconv.Itoa(int(parsedResponseElements.Field(i).Int()))
+		case reflect.TypeOf(NullDecimal{}):
+			currentResult := parsedResponseElements.Field(i).Interface().(NullDecimal).String()
+			assert.Equal(t, expected, currentResult, varName)
+		case reflect.TypeOf(NullInt64{}):
+			currentResult := parsedResponseElements.Field(i).Interface().(NullInt64).String()
 			assert.Equal(t, expected, currentResult, varName)
 		case reflect.TypeOf(Date{}):
 			currentResult := parsedResponseElements.Field(i).Interface().(Date).String()
@@ -188,13 +180,13 @@ func TestIBMIncomeStatement(t *testing.T) {
 		},
 	}
 
-	parseInt := func(v string) int64 {
+	parseInt := func(v string) NullInt64 {
 		switch v {
 		case "None":
-			return 0
+			return NullInt64{}
 		default:
 			res, _ := strconv.Atoi(v)
-			return int64(res)
+			return NewNullInt64(int64(res))
 		}
 	}
 
@@ -220,7 +212,7 @@ func TestIBMIncomeStatement(t *testing.T) {
 			panic(fmt.Sprintf("unexpected form type '%s'", form))
 		}
 
-		parsedResponse, err := fromIncomeStatement(apiResponse, formType)
+		parsedResponse, err := fromIncomeStatement(apiResponse, formType, false)
 		require.NoError(t, err)
 
 		inputApiElements := reflect.ValueOf(&apiResponse).Elem()
@@ -232,9 +224,9 @@ func TestIBMIncomeStatement(t *testing.T) {
 
 			varType := parsedResponseElements.Type().Field(i).Type
 			switch varType {
-			case reflect.TypeOf(int64(0)):
+			case reflect.TypeOf(NullInt64{}):
 				expectedResult := parseInt(inputValue)
-				currentResult := int64(parsedResponseElements.Field(i).Int())
+				currentResult := parsedResponseElements.Field(i).Interface().(NullInt64)
 				assert.Equal(t, expectedResult, currentResult, varName)
 			case reflect.TypeOf(Date{}):
 				expectedResult := parseDate(inputValue)
@@ -309,13 +301,13 @@ func TestIBMCashFlow(t *testing.T) {
 		},
 	}
 
-	parseInt := func(v string) int64 {
+	parseInt := func(v string) NullInt64 {
 		switch v {
 		case "None":
-			return 0
+			return NullInt64{}
 		default:
 			res, _ := strconv.Atoi(v)
-			return int64(res)
+			return NewNullInt64(int64(res))
 		}
 	}
 
@@ -341,7 +333,7 @@ func TestIBMCashFlow(t *testing.T) {
 			panic(fmt.Sprintf("unexpected form type '%s'", form))
 		}
 
-		parsedResponse, err := fromCashFlow(apiResponse, formType)
+		parsedResponse, err := fromCashFlow(apiResponse, formType, false)
 		require.NoError(t, err)
 
 		inputApiElements := reflect.ValueOf(&apiResponse).Elem()
@@ -353,9 +345,9 @@ func TestIBMCashFlow(t *testing.T) {
 
 			varType := parsedResponseElements.Type().Field(i).Type
 			switch varType {
-			case reflect.TypeOf(int64(0)):
+			case reflect.TypeOf(NullInt64{}):
 				expectedResult := parseInt(inputValue)
-				currentResult := int64(parsedResponseElements.Field(i).Int())
+				currentResult := parsedResponseElements.Field(i).Interface().(NullInt64)
 				assert.Equal(t, expectedResult, currentResult, varName)
 			case reflect.TypeOf(Date{}):
 				expectedResult := parseDate(inputValue)
@@ -484,13 +476,13 @@ func TestIBMBalanceSheet(t *testing.T) {
 		},
 	}
 
-	parseInt := func(v string) int64 {
+	parseInt := func(v string) NullInt64 {
 		switch v {
 		case "None":
-			return 0
+			return NullInt64{}
 		default:
 			res, _ := strconv.Atoi(v)
-			return int64(res)
+			return NewNullInt64(int64(res))
 		}
 	}
 
@@ -516,7 +508,7 @@ func TestIBMBalanceSheet(t *testing.T) {
 			panic(fmt.Sprintf("unexpected form type '%s'", form))
 		}
 
-		parsedResponse, err := fromBalanceSheet(apiResponse, formType)
+		parsedResponse, err := fromBalanceSheet(apiResponse, formType, false)
 		require.NoError(t, err)
 
 		inputApiElements := reflect.ValueOf(&apiResponse).Elem()
@@ -528,9 +520,9 @@ func TestIBMBalanceSheet(t *testing.T) {
 
 			varType := parsedResponseElements.Type().Field(i).Type
 			switch varType {
-			case reflect.TypeOf(int64(0)):
+			case reflect.TypeOf(NullInt64{}):
 				expectedResult := parseInt(inputValue)
-				currentResult := int64(parsedResponseElements.Field(i).Int())
+				currentResult := parsedResponseElements.Field(i).Interface().(NullInt64)
 				assert.Equal(t, expectedResult, currentResult, varName)
 			case reflect.TypeOf(Date{}):
 				expectedResult := parseDate(inputValue)
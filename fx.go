@@ -0,0 +1,362 @@
+package alphavantage
+
+import (
+	"context"
+	"net/url"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// fxTimeZone is used for FX intraday CSV responses, which do not carry "Meta Data"
+const fxTimeZone = "UTC"
+
+// ExchangeRate realtime exchange rate of a currency pair
+type ExchangeRate struct {
+	FromCurrency     string  `json:"fromCurrency"`
+	FromCurrencyName string  `json:"fromCurrencyName"`
+	ToCurrency       string  `json:"toCurrency"`
+	ToCurrencyName   string  `json:"toCurrencyName"`
+	Rate             Decimal `json:"rate"`
+	// LastRefreshed is in TimeZone
+	LastRefreshed time.Time   `json:"lastRefreshed"`
+	TimeZone      string      `json:"timeZone"`
+	Bid           NullDecimal `json:"bid"`
+	Ask           NullDecimal `json:"ask"`
+}
+
+// FXOptions optional parameters of FX time series requests, the zero value uses alphavantage defaults
+type FXOptions struct {
+	// OutputSize is supported by daily series only
+	OutputSize OutputSize
+	DataType   DataType
+}
+
+// FXIntradayOptions parameters of FX intraday requests, Interval is required
+type FXIntradayOptions struct {
+	Interval   Interval
+	OutputSize OutputSize
+	DataType   DataType
+}
+
+// FXMetadata parsed "Meta Data" block of FX time series responses
+type FXMetadata struct {
+	Information   string `json:"information"`
+	FromSymbol    string `json:"fromSymbol"`
+	ToSymbol      string `json:"toSymbol"`
+	LastRefreshed string `json:"lastRefreshed"`
+	Interval      string `json:"interval,omitempty"`
+	OutputSize    string `json:"outputSize,omitempty"`
+	TimeZone      string `json:"timeZone"`
+}
+
+// FXBar open, high, low and close exchange rates of a single period, FX series carry no volume
+type FXBar struct {
+	Date  Date    `json:"date"`
+	Open  Decimal `json:"open"`
+	High  Decimal `json:"high"`
+	Low   Decimal `json:"low"`
+	Close Decimal `json:"close"`
+}
+
+// FXIntradayBar open, high, low and close exchange rates of a single intraday interval
+type FXIntradayBar struct {
+	// Time is the interval timestamp in the time zone of the response Meta Data
+	Time  time.Time `json:"time"`
+	Open  Decimal   `json:"open"`
+	High  Decimal   `json:"high"`
+	Low   Decimal   `json:"low"`
+	Close Decimal   `json:"close"`
+}
+
+// FXTimeSeries FX bars in chronological order
+type FXTimeSeries struct {
+	Metadata FXMetadata `json:"metadata"`
+	Bars     []FXBar    `json:"bars"`
+}
+
+// FXIntradayTimeSeries FX intraday bars in chronological order
+type FXIntradayTimeSeries struct {
+	Metadata FXMetadata      `json:"metadata"`
+	Bars     []FXIntradayBar `json:"bars"`
+}
+
+// CurrencyExchangeRate makes API request and returns parsed response, currencies are ISO 4217 codes, e.g. "USD"
+func (c *Client) CurrencyExchangeRate(ctx context.Context, from string, to string) (ExchangeRate, error) {
+	params, err := currencyPairParams("from_currency", from, "to_currency", to)
+	if err != nil {
+		return ExchangeRate{}, errors.Wrap(err, "CurrencyExchangeRate error")
+	}
+	response := rawExchangeRateResponse{}
+	if err := c.request(ctx, "CURRENCY_EXCHANGE_RATE", params, &response); err != nil {
+		return ExchangeRate{}, errors.Wrap(err, "CurrencyExchangeRate error")
+	}
+	if len(response.ExchangeRate) == 0 {
+		return ExchangeRate{}, errors.Wrapf(ErrInvalidSymbol, "CurrencyExchangeRate error: no rate for '%s/%s'", from, to)
+	}
+	res, err := fromExchangeRate(response.ExchangeRate, c.collectParseErrors)
+	if err != nil {
+		return ExchangeRate{}, errors.Wrap(err, "CurrencyExchangeRate parsing error")
+	}
+	return res, nil
+}
+
+// FXIntraday makes API request and returns parsed response
+func (c *Client) FXIntraday(ctx context.Context, from string, to string, opts FXIntradayOptions) (FXIntradayTimeSeries, error) {
+	if !opts.Interval.valid() {
+		return FXIntradayTimeSeries{}, errors.Errorf("FXIntraday error: unsupported interval '%s'", opts.Interval)
+	}
+	params, err := currencyPairParams("from_symbol", from, "to_symbol", to)
+	if err != nil {
+		return FXIntradayTimeSeries{}, errors.Wrap(err, "FXIntraday error")
+	}
+	params.Set("interval", string(opts.Interval))
+	if opts.OutputSize != "" {
+		params.Set("outputsize", string(opts.OutputSize))
+	}
+	if opts.DataType != "" {
+		params.Set("datatype", string(opts.DataType))
+	}
+	if isCSV(params) {
+		return c.fxIntradayCSV(ctx, params)
+	}
+	response := rawTimeSeriesResponse{}
+	if err := c.request(ctx, "FX_INTRADAY", params, &response); err != nil {
+		return FXIntradayTimeSeries{}, errors.Wrap(err, "FXIntraday error")
+	}
+	res, err := fromFXIntradayResponse(response, c.collectParseErrors)
+	if err != nil {
+		return FXIntradayTimeSeries{}, errors.Wrap(err, "FXIntraday parsing error")
+	}
+	return res, nil
+}
+
+// FXDaily makes API request and returns parsed response
+func (c *Client) FXDaily(ctx context.Context, from string, to string, opts FXOptions) (FXTimeSeries, error) {
+	return c.fxTimeSeries(ctx, "FXDaily", "FX_DAILY", from, to, opts)
+}
+
+// FXWeekly makes API request and returns parsed response
+func (c *Client) FXWeekly(ctx context.Context, from string, to string, opts FXOptions) (FXTimeSeries, error) {
+	return c.fxTimeSeries(ctx, "FXWeekly", "FX_WEEKLY", from, to, opts)
+}
+
+// FXMonthly makes API request and returns parsed response
+func (c *Client) FXMonthly(ctx context.Context, from string, to string, opts FXOptions) (FXTimeSeries, error) {
+	return c.fxTimeSeries(ctx, "FXMonthly", "FX_MONTHLY", from, to, opts)
+}
+
+func (c *Client) fxTimeSeries(ctx context.Context, name string, function string, from string, to string, opts FXOptions) (FXTimeSeries, error) {
+	params, err := currencyPairParams("from_symbol", from, "to_symbol", to)
+	if err != nil {
+		return FXTimeSeries{}, errors.Wrapf(err, "%s error", name)
+	}
+	if opts.OutputSize != "" {
+		params.Set("outputsize", string(opts.OutputSize))
+	}
+	if opts.DataType != "" {
+		params.Set("datatype", string(opts.DataType))
+	}
+	if isCSV(params) {
+		res := FXTimeSeries{Metadata: FXMetadata{FromSymbol: params.Get("from_symbol"), ToSymbol: params.Get("to_symbol")}}
+		var parseErrs ParseErrors
+		err := c.requestCSV(ctx, function, params, func(row map[string]string) error {
+			b, err := fromFXBar(row["timestamp"], row, c.collectParseErrors)
+			if err != nil {
+				if parseErrs.collect(err) {
+					return nil
+				}
+				return errors.Wrapf(err, "%s parsing error", name)
+			}
+			res.Bars = append(res.Bars, b)
+			return nil
+		})
+		if err != nil {
+			return FXTimeSeries{}, errors.Wrapf(err, "%s error", name)
+		}
+		if len(parseErrs) > 0 {
+			return FXTimeSeries{}, errors.Wrapf(parseErrs, "%s parsing error", name)
+		}
+		sortFXBars(res.Bars)
+		return res, nil
+	}
+	response := rawTimeSeriesResponse{}
+	if err := c.request(ctx, function, params, &response); err != nil {
+		return FXTimeSeries{}, errors.Wrapf(err, "%s error", name)
+	}
+	res, err := fromFXTimeSeriesResponse(response, c.collectParseErrors)
+	if err != nil {
+		return FXTimeSeries{}, errors.Wrapf(err, "%s parsing error", name)
+	}
+	return res, nil
+}
+
+func (c *Client) fxIntradayCSV(ctx context.Context, params url.Values) (FXIntradayTimeSeries, error) {
+	res := FXIntradayTimeSeries{Metadata: FXMetadata{
+		FromSymbol: params.Get("from_symbol"),
+		ToSymbol:   params.Get("to_symbol"),
+		Interval:   params.Get("interval"),
+		TimeZone:   fxTimeZone,
+	}}
+	var parseErrs ParseErrors
+	err := c.requestCSV(ctx, "FX_INTRADAY", params, func(row map[string]string) error {
+		b, err := fromFXIntradayBar(row["timestamp"], row, time.UTC, c.collectParseErrors)
+		if err != nil {
+			if parseErrs.collect(err) {
+				return nil
+			}
+			return errors.Wrap(err, "FXIntraday parsing error")
+		}
+		res.Bars = append(res.Bars, b)
+		return nil
+	})
+	if err != nil {
+		return FXIntradayTimeSeries{}, errors.Wrap(err, "FXIntraday error")
+	}
+	if len(parseErrs) > 0 {
+		return FXIntradayTimeSeries{}, errors.Wrap(parseErrs, "FXIntraday parsing error")
+	}
+	sortFXIntradayBars(res.Bars)
+	return res, nil
+}
+
+// currencyPairParams validates currency codes before spending a request on a typo
+func currencyPairParams(fromKey string, from string, toKey string, to string) (url.Values, error) {
+	fromCode, err := parseCurrencyCode(from)
+	if err != nil {
+		return nil, err
+	}
+	toCode, err := parseCurrencyCode(to)
+	if err != nil {
+		return nil, err
+	}
+	return url.Values{fromKey: {fromCode}, toKey: {toCode}}, nil
+}
+
+type rawExchangeRateResponse struct {
+	ExchangeRate map[string]string `json:"Realtime Currency Exchange Rate"`
+}
+
+func fromExchangeRate(raw map[string]string, collectAll bool) (ExchangeRate, error) {
+	fields := make(map[string]string, len(raw))
+	for k, v := range raw {
+		fields[stripKeyIndex(k)] = v
+	}
+	p := newFieldParser("ExchangeRate", fields["From_Currency Code"]+"/"+fields["To_Currency Code"], collectAll)
+	res := ExchangeRate{
+		FromCurrency:     fields["From_Currency Code"],
+		FromCurrencyName: fields["From_Currency Name"],
+		ToCurrency:       fields["To_Currency Code"],
+		ToCurrencyName:   fields["To_Currency Name"],
+		Rate:             p.decimal("Exchange Rate", fields["Exchange Rate"]),
+		TimeZone:         fields["Time Zone"],
+		Bid:              p.nullDecimal("Bid Price", fields["Bid Price"]),
+		Ask:              p.nullDecimal("Ask Price", fields["Ask Price"]),
+	}
+	loc, err := time.LoadLocation(res.TimeZone)
+	if err != nil {
+		p.fail("Time Zone", res.TimeZone, errors.Wrapf(err, "Cannot load time zone '%s'", res.TimeZone))
+		return res, p.err()
+	}
+	res.LastRefreshed = p.time("Last Refreshed", fields["Last Refreshed"], intradayLayout, loc)
+	return res, p.err()
+}
+
+func (r rawTimeSeriesResponse) fxMetadata() FXMetadata {
+	return FXMetadata{
+		Information:   r.Metadata["Information"],
+		FromSymbol:    r.Metadata["From Symbol"],
+		ToSymbol:      r.Metadata["To Symbol"],
+		LastRefreshed: r.Metadata["Last Refreshed"],
+		Interval:      r.Metadata["Interval"],
+		OutputSize:    r.Metadata["Output Size"],
+		TimeZone:      r.Metadata["Time Zone"],
+	}
+}
+
+func fromFXTimeSeriesResponse(response rawTimeSeriesResponse, collectAll bool) (FXTimeSeries, error) {
+	res := FXTimeSeries{
+		Metadata: response.fxMetadata(),
+		Bars:     make([]FXBar, 0, len(response.Series)),
+	}
+	var parseErrs ParseErrors
+	for date, fields := range response.Series {
+		b, err := fromFXBar(date, fields, collectAll)
+		if err != nil {
+			if parseErrs.collect(err) {
+				continue
+			}
+			return FXTimeSeries{}, err
+		}
+		res.Bars = append(res.Bars, b)
+	}
+	if len(parseErrs) > 0 {
+		return FXTimeSeries{}, parseErrs
+	}
+	sortFXBars(res.Bars)
+	return res, nil
+}
+
+func fromFXIntradayResponse(response rawTimeSeriesResponse, collectAll bool) (FXIntradayTimeSeries, error) {
+	res := FXIntradayTimeSeries{
+		Metadata: response.fxMetadata(),
+		Bars:     make([]FXIntradayBar, 0, len(response.Series)),
+	}
+	var parseErrs ParseErrors
+	loc, err := time.LoadLocation(res.Metadata.TimeZone)
+	if err != nil {
+		return FXIntradayTimeSeries{}, errors.Wrapf(err, "Cannot load time zone '%s'", res.Metadata.TimeZone)
+	}
+	for timestamp, fields := range response.Series {
+		b, err := fromFXIntradayBar(timestamp, fields, loc, collectAll)
+		if err != nil {
+			if parseErrs.collect(err) {
+				continue
+			}
+			return FXIntradayTimeSeries{}, err
+		}
+		res.Bars = append(res.Bars, b)
+	}
+	if len(parseErrs) > 0 {
+		return FXIntradayTimeSeries{}, parseErrs
+	}
+	sortFXIntradayBars(res.Bars)
+	return res, nil
+}
+
+func sortFXBars(bars []FXBar) {
+	sort.Slice(bars, func(i, j int) bool {
+		return time.Time(bars[i].Date).Before(time.Time(bars[j].Date))
+	})
+}
+
+func sortFXIntradayBars(bars []FXIntradayBar) {
+	sort.Slice(bars, func(i, j int) bool {
+		return bars[i].Time.Before(bars[j].Time)
+	})
+}
+
+func fromFXBar(date string, fields map[string]string, collectAll bool) (FXBar, error) {
+	p := newFieldParser("FXBar", date, collectAll)
+	res := FXBar{
+		Date:  p.date("date", date),
+		Open:  p.decimal("open", fields["open"]),
+		High:  p.decimal("high", fields["high"]),
+		Low:   p.decimal("low", fields["low"]),
+		Close: p.decimal("close", fields["close"]),
+	}
+	return res, p.err()
+}
+
+func fromFXIntradayBar(timestamp string, fields map[string]string, loc *time.Location, collectAll bool) (FXIntradayBar, error) {
+	p := newFieldParser("FXIntradayBar", timestamp, collectAll)
+	res := FXIntradayBar{
+		Time:  p.time("time", timestamp, intradayLayout, loc),
+		Open:  p.decimal("open", fields["open"]),
+		High:  p.decimal("high", fields["high"]),
+		Low:   p.decimal("low", fields["low"]),
+		Close: p.decimal("close", fields["close"]),
+	}
+	return res, p.err()
+}
@@ -0,0 +1,254 @@
+package alphavantage
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testExchangeRate = []byte(`
+{
+	"Realtime Currency Exchange Rate": {
+		"1. From_Currency Code": "USD",
+		"2. From_Currency Name": "United States Dollar",
+		"3. To_Currency Code": "JPY",
+		"4. To_Currency Name": "Japanese Yen",
+		"5. Exchange Rate": "149.52300000",
+		"6. Last Refreshed": "2024-01-02 14:05:01",
+		"7. Time Zone": "UTC",
+		"8. Bid Price": "149.52000000",
+		"9. Ask Price": "-"
+	}
+}`)
+
+var testFXDaily = []byte(`
+{
+	"Meta Data": {
+		"1. Information": "Forex Daily Prices (open, high, low, close)",
+		"2. From Symbol": "EUR",
+		"3. To Symbol": "USD",
+		"4. Output Size": "Compact",
+		"5. Last Refreshed": "2024-01-03 14:00:00",
+		"6. Time Zone": "UTC"
+	},
+	"Time Series FX (Daily)": {
+		"2024-01-03": {
+			"1. open": "1.09420",
+			"2. high": "1.09550",
+			"3. low": "1.09010",
+			"4. close": "1.09160"
+		},
+		"2024-01-02": {
+			"1. open": "1.10380",
+			"2. high": "1.10450",
+			"3. low": "1.09390",
+			"4. close": "1.09420"
+		}
+	}
+}`)
+
+var testFXIntraday = []byte(`
+{
+	"Meta Data": {
+		"1. Information": "FX Intraday (5min) Time Series",
+		"2. From Symbol": "EUR",
+		"3. To Symbol": "USD",
+		"4. Last Refreshed": "2024-01-02 14:05:00",
+		"5. Interval": "5min",
+		"6. Output Size": "Compact",
+		"7. Time Zone": "UTC"
+	},
+	"Time Series FX (5min)": {
+		"2024-01-02 14:05:00": {
+			"1. open": "1.09420",
+			"2. high": "1.09440",
+			"3. low": "1.09400",
+			"4. close": "1.09430"
+		},
+		"2024-01-02 14:00:00": {
+			"1. open": "1.09380",
+			"2. high": "1.09430",
+			"3. low": "1.09370",
+			"4. close": "1.09420"
+		}
+	}
+}`)
+
+func TestCurrencyExchangeRate(t *testing.T) {
+	httpClient := &fakeHTTPClient{StatusCode: http.StatusOK, Result: testExchangeRate}
+	client := NewClient(WithHTTPClient(httpClient), WithAPIKey("demo"))
+
+	res, err := client.CurrencyExchangeRate(context.TODO(), "usd", "JPY")
+	require.NoError(t, err)
+	assert.Equal(t, "https://www.alphavantage.co/query?function=CURRENCY_EXCHANGE_RATE&from_currency=USD&to_currency=JPY&apikey=demo", httpClient.Request.URL.String())
+
+	assert.Equal(t, "USD", res.FromCurrency)
+	assert.Equal(t, "United States Dollar", res.FromCurrencyName)
+	assert.Equal(t, "JPY", res.ToCurrency)
+	assert.Equal(t, "Japanese Yen", res.ToCurrencyName)
+	assert.Equal(t, "149.52300000", res.Rate.String())
+	assert.Equal(t, "UTC", res.TimeZone)
+	assert.True(t, res.LastRefreshed.Equal(time.Date(2024, 1, 2, 14, 5, 1, 0, time.UTC)))
+	assert.Equal(t, "149.52000000", res.Bid.String())
+	assert.False(t, res.Ask.Valid)
+}
+
+func TestCurrencyExchangeRateErrors(t *testing.T) {
+	httpClient := &fakeHTTPClient{StatusCode: http.StatusOK, Result: testExchangeRate}
+	client := NewClient(WithHTTPClient(httpClient))
+
+	_, err := client.CurrencyExchangeRate(context.TODO(), "USD", "EUD")
+	assert.Error(t, err)
+	assert.Nil(t, httpClient.Request)
+
+	httpClient.Result = []byte(`{"Realtime Currency Exchange Rate": {"1. From_Currency Code": "USD", "3. To_Currency Code": "JPY", "5. Exchange Rate": "1,000", "6. Last Refreshed": "2024-01-02 14:05:01", "7. Time Zone": "UTC"}}`)
+	_, err = client.CurrencyExchangeRate(context.TODO(), "USD", "JPY")
+	require.Error(t, err)
+	var parseErr *ParseError
+	require.True(t, errors.As(err, &parseErr))
+	assert.Equal(t, "ExchangeRate", parseErr.Statement)
+	assert.Equal(t, "USD/JPY", parseErr.Record)
+	assert.Equal(t, "Exchange Rate", parseErr.Field)
+
+	httpClient.Result = []byte(`{"Realtime Currency Exchange Rate": {}}`)
+	_, err = client.CurrencyExchangeRate(context.TODO(), "USD", "JPY")
+	assert.True(t, errors.Is(err, ErrInvalidSymbol))
+}
+
+func TestFXTimeSeries(t *testing.T) {
+	testCases := map[string]struct {
+		call        func(*Client) (FXTimeSeries, error)
+		expectedURL string
+	}{
+		"FXDaily": {
+			call: func(c *Client) (FXTimeSeries, error) {
+				return c.FXDaily(context.TODO(), "EUR", "USD", FXOptions{OutputSize: OutputSizeFull})
+			},
+			expectedURL: "https://www.alphavantage.co/query?function=FX_DAILY&from_symbol=EUR&outputsize=full&to_symbol=USD&apikey=demo",
+		},
+		"FXWeekly": {
+			call: func(c *Client) (FXTimeSeries, error) {
+				return c.FXWeekly(context.TODO(), "EUR", "USD", FXOptions{})
+			},
+			expectedURL: "https://www.alphavantage.co/query?function=FX_WEEKLY&from_symbol=EUR&to_symbol=USD&apikey=demo",
+		},
+		"FXMonthly": {
+			call: func(c *Client) (FXTimeSeries, error) {
+				return c.FXMonthly(context.TODO(), "EUR", "USD", FXOptions{})
+			},
+			expectedURL: "https://www.alphavantage.co/query?function=FX_MONTHLY&from_symbol=EUR&to_symbol=USD&apikey=demo",
+		},
+	}
+
+	for name, tc := range testCases {
+		httpClient := &fakeHTTPClient{StatusCode: http.StatusOK, Result: testFXDaily}
+		client := NewClient(WithHTTPClient(httpClient), WithAPIKey("demo"))
+
+		res, err := tc.call(client)
+		require.NoError(t, err, name)
+		assert.Equal(t, tc.expectedURL, httpClient.Request.URL.String(), name)
+		assert.Equal(t, FXMetadata{
+			Information:   "Forex Daily Prices (open, high, low, close)",
+			FromSymbol:    "EUR",
+			ToSymbol:      "USD",
+			LastRefreshed: "2024-01-03 14:00:00",
+			OutputSize:    "Compact",
+			TimeZone:      "UTC",
+		}, res.Metadata, name)
+		require.Len(t, res.Bars, 2, name)
+		assert.Equal(t, "2024-01-02", res.Bars[0].Date.String(), name)
+		assert.Equal(t, "1.10380", res.Bars[0].Open.String(), name)
+		assert.Equal(t, "1.10450", res.Bars[0].High.String(), name)
+		assert.Equal(t, "1.09390", res.Bars[0].Low.String(), name)
+		assert.Equal(t, "1.09420", res.Bars[0].Close.String(), name)
+		assert.Equal(t, "2024-01-03", res.Bars[1].Date.String(), name)
+	}
+}
+
+func TestFXTimeSeriesErrors(t *testing.T) {
+	httpClient := &fakeHTTPClient{StatusCode: http.StatusOK, Result: testFXDaily}
+	client := NewClient(WithHTTPClient(httpClient))
+
+	_, err := client.FXDaily(context.TODO(), "EURO", "USD", FXOptions{})
+	assert.Error(t, err)
+	assert.Nil(t, httpClient.Request)
+
+	httpClient.Result = []byte(`{"Meta Data": {}, "Time Series FX (Daily)": {"2024-01-02": {"1. open": "1.1", "2. high": "1.1", "3. low": "n/a", "4. close": "1.1"}}}`)
+	_, err = client.FXDaily(context.TODO(), "EUR", "USD", FXOptions{})
+	require.Error(t, err)
+	var parseErr *ParseError
+	require.True(t, errors.As(err, &parseErr))
+	assert.Equal(t, "FXBar", parseErr.Statement)
+	assert.Equal(t, "2024-01-02", parseErr.Record)
+	assert.Equal(t, "low", parseErr.Field)
+}
+
+func TestFXIntraday(t *testing.T) {
+	httpClient := &fakeHTTPClient{StatusCode: http.StatusOK, Result: testFXIntraday}
+	client := NewClient(WithHTTPClient(httpClient), WithAPIKey("demo"))
+
+	res, err := client.FXIntraday(context.TODO(), "EUR", "USD", FXIntradayOptions{Interval: Interval5Min})
+	require.NoError(t, err)
+	assert.Equal(t, "https://www.alphavantage.co/query?function=FX_INTRADAY&from_symbol=EUR&interval=5min&to_symbol=USD&apikey=demo", httpClient.Request.URL.String())
+
+	assert.Equal(t, "5min", res.Metadata.Interval)
+	require.Len(t, res.Bars, 2)
+	assert.True(t, res.Bars[0].Time.Equal(time.Date(2024, 1, 2, 14, 0, 0, 0, time.UTC)))
+	assert.Equal(t, "1.09380", res.Bars[0].Open.String())
+	assert.True(t, res.Bars[1].Time.Equal(time.Date(2024, 1, 2, 14, 5, 0, 0, time.UTC)))
+	assert.Equal(t, "1.09430", res.Bars[1].Close.String())
+}
+
+func TestFXIntradayInvalidParams(t *testing.T) {
+	httpClient := &fakeHTTPClient{StatusCode: http.StatusOK, Result: testFXIntraday}
+	client := NewClient(WithHTTPClient(httpClient))
+
+	_, err := client.FXIntraday(context.TODO(), "EUR", "USD", FXIntradayOptions{Interval: "2min"})
+	assert.Error(t, err)
+	_, err = client.FXIntraday(context.TODO(), "EUR", "", FXIntradayOptions{Interval: Interval5Min})
+	assert.Error(t, err)
+	assert.Nil(t, httpClient.Request)
+}
+
+var testFXDailyCSV = []byte("timestamp,open,high,low,close\r\n" +
+	"2024-01-03,1.09420,1.09550,1.09010,1.09160\r\n" +
+	"2024-01-02,1.10380,1.10450,1.09390,1.09420\r\n")
+
+var testFXIntradayCSV = []byte("timestamp,open,high,low,close\r\n" +
+	"2024-01-02 14:05:00,1.09420,1.09440,1.09400,1.09430\r\n" +
+	"2024-01-02 14:00:00,1.09380,1.09430,1.09370,1.09420\r\n")
+
+func TestFXTimeSeriesCSVMatchesJSON(t *testing.T) {
+	jsonClient := NewClient(WithHTTPClient(&fakeHTTPClient{StatusCode: http.StatusOK, Result: testFXDaily}))
+	csvHTTPClient := &fakeHTTPClient{StatusCode: http.StatusOK, Result: testFXDailyCSV}
+	csvClient := NewClient(WithHTTPClient(csvHTTPClient), WithAPIKey("demo"))
+
+	fromJSON, err := jsonClient.FXDaily(context.TODO(), "EUR", "USD", FXOptions{})
+	require.NoError(t, err)
+	fromCSV, err := csvClient.FXDaily(context.TODO(), "EUR", "USD", FXOptions{DataType: DataTypeCSV})
+	require.NoError(t, err)
+	assert.Equal(t, "https://www.alphavantage.co/query?function=FX_DAILY&datatype=csv&from_symbol=EUR&to_symbol=USD&apikey=demo", csvHTTPClient.Request.URL.String())
+	assert.Equal(t, FXMetadata{FromSymbol: "EUR", ToSymbol: "USD"}, fromCSV.Metadata)
+	require.Len(t, fromCSV.Bars, 2)
+	assert.Equal(t, fromJSON.Bars, fromCSV.Bars)
+}
+
+func TestFXIntradayCSVMatchesJSON(t *testing.T) {
+	jsonClient := NewClient(WithHTTPClient(&fakeHTTPClient{StatusCode: http.StatusOK, Result: testFXIntraday}))
+	csvHTTPClient := &fakeHTTPClient{StatusCode: http.StatusOK, Result: testFXIntradayCSV}
+	csvClient := NewClient(WithHTTPClient(csvHTTPClient), WithAPIKey("demo"))
+
+	fromJSON, err := jsonClient.FXIntraday(context.TODO(), "EUR", "USD", FXIntradayOptions{Interval: Interval5Min})
+	require.NoError(t, err)
+	fromCSV, err := csvClient.FXIntraday(context.TODO(), "EUR", "USD", FXIntradayOptions{Interval: Interval5Min, DataType: DataTypeCSV})
+	require.NoError(t, err)
+	assert.Equal(t, "https://www.alphavantage.co/query?function=FX_INTRADAY&datatype=csv&from_symbol=EUR&interval=5min&to_symbol=USD&apikey=demo", csvHTTPClient.Request.URL.String())
+	assert.Equal(t, FXMetadata{FromSymbol: "EUR", ToSymbol: "USD", Interval: "5min", TimeZone: "UTC"}, fromCSV.Metadata)
+	require.Len(t, fromCSV.Bars, 2)
+	assert.Equal(t, fromJSON.Bars, fromCSV.Bars)
+}
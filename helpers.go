@@ -1,14 +1,20 @@
 package alphavantage
 
 import (
+	"bufio"
 	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"log"
 	"net/http"
+	"net/url"
+	"sort"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 )
@@ -21,43 +27,164 @@ type HTTPClient interface {
 }
 
 func buildURL(apiKey string, function string, symbol string) string {
-	return fmt.Sprintf("%s/query?function=%s&symbol=%s&apikey=%s", aplhavantageURL, function, symbol, apiKey)
+	return buildQueryURL(aplhavantageURL, apiKey, function, symbolParams(symbol))
 }
 
-func panicParseInt64ish(v string) int64 {
+func symbolParams(symbol string) url.Values {
+	return url.Values{"symbol": {symbol}}
+}
+
+// buildQueryURL builds query URL with function first, the rest of params sorted by name and apikey last
+func buildQueryURL(baseURL string, apiKey string, function string, params url.Values) string {
+	return fmt.Sprintf("%s/query?function=%s%s&apikey=%s", strings.TrimRight(baseURL, "/"), url.QueryEscape(function), encodeParams(params), url.QueryEscape(apiKey))
+}
+
+// encodeParams encodes params sorted by name, every param is prefixed with '&'
+func encodeParams(params url.Values) string {
+	keys := make([]string, 0, len(params))
+	for key := range params {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var sb strings.Builder
+	for _, key := range keys {
+		for _, value := range params[key] {
+			sb.WriteString("&")
+			sb.WriteString(url.QueryEscape(key))
+			sb.WriteString("=")
+			sb.WriteString(url.QueryEscape(value))
+		}
+	}
+	return sb.String()
+}
+
+// isAbsentValue reports whether v is one of alphavantage placeholders for missing values
+func isAbsentValue(v string) bool {
+	switch v {
+	case "", "None", "-", "null", "nil":
+		return true
+	default:
+		return false
+	}
+}
+
+// parseInt64ish parses alphavantage integer types
+func parseInt64ish(v string) (int64, error) {
 	if v == "None" {
-		return 0
+		return 0, nil
 	}
 	res, err := strconv.ParseInt(v, 10, 64)
 	if err != nil {
-		panic(errors.Wrapf(err, "Cannot parse '%s'", v))
+		return 0, errors.Wrapf(err, "Cannot parse '%s'", v)
 	}
-	return res
+	return res, nil
 }
 
 func makeRequest(ctx context.Context, httpClient HTTPClient, url string, v interface{}) error {
+	req, err := newRequest(ctx, url)
+	if err != nil {
+		return err
+	}
+	body, err := fetch(httpClient, req)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(body, v)
+}
+
+func newRequest(ctx context.Context, url string) (*http.Request, error) {
 	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
 	if err != nil {
-		return errors.Wrap(err, "Error creating http.Request")
+		return nil, errors.Wrap(err, "Error creating http.Request")
 	}
 	req.Header.Add("Content-Type", "application/json")
+	return req, nil
+}
+
+// fetch makes HTTP call and returns response body, non-200 statuses and soft errors are returned as errors
+func fetch(httpClient HTTPClient, req *http.Request) ([]byte, error) {
+	body, err := open(httpClient, req)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	res, err := ioutil.ReadAll(body)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error reading result.Body")
+	}
+	return res, nil
+}
 
+// open makes HTTP call and returns response body, non-200 statuses and soft errors are returned as errors.
+// Soft errors come as JSON objects, so JSON bodies are read in full to check them, other bodies are streamed.
+func open(httpClient HTTPClient, req *http.Request) (io.ReadCloser, error) {
 	res, err := httpClient.Do(req)
 	if err != nil {
-		return errors.Wrap(err, "Error during HTTP call")
+		return nil, errors.Wrap(err, "Error during HTTP call")
 	}
-	defer res.Body.Close()
 
 	if res.StatusCode != http.StatusOK {
-		var buf bytes.Buffer
-		_, err = io.Copy(&buf, res.Body)
+		defer res.Body.Close()
+		body, err := ioutil.ReadAll(res.Body)
 		if err != nil {
-			return errors.Wrap(err, "Error reading result.Body")
+			return nil, errors.Wrap(err, "Error reading result.Body")
 		}
+		log.Printf("[DEBUG] %s\n", body)
+		return nil, &HTTPError{
+			StatusCode: res.StatusCode,
+			RetryAfter: parseRetryAfter(res.Header.Get("Retry-After"), time.Now()),
+		}
+	}
+
+	reader := bufio.NewReader(res.Body)
+	if !startsWithJSONObject(reader) {
+		return &readCloser{Reader: reader, Closer: res.Body}, nil
+	}
+	defer res.Body.Close()
+	body, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error reading result.Body")
+	}
+	if err := checkSoftError(body); err != nil {
+		return nil, err
+	}
+	return ioutil.NopCloser(bytes.NewReader(body)), nil
+}
 
-		log.Printf("[DEBUG] %s\n", buf.String())
-		return errors.Errorf("Alphavantage HTTP Status %d", res.StatusCode)
+// startsWithJSONObject skips leading white space of r and reports whether the rest starts with '{'
+func startsWithJSONObject(r *bufio.Reader) bool {
+	for {
+		b, err := r.Peek(1)
+		if err != nil {
+			return false
+		}
+		switch b[0] {
+		case ' ', '\t', '\r', '\n':
+			_, _ = r.Discard(1)
+		case '{':
+			return true
+		default:
+			return false
+		}
 	}
+}
 
-	return json.NewDecoder(res.Body).Decode(v)
+// readCloser reads from Reader and closes Closer
+type readCloser struct {
+	io.Reader
+	io.Closer
+}
+
+// sleepContext waits for d or until ctx is done
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
 }
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestInt64Parse(t *testing.T) {
@@ -18,12 +19,13 @@ func TestInt64Parse(t *testing.T) {
 	}
 
 	for input, expectedResult := range testCases {
-		actualResult := panicParseInt64ish(input)
+		actualResult, err := parseInt64ish(input)
+		require.NoError(t, err)
 		assert.Equal(t, actualResult, expectedResult)
 	}
 }
 
-func TestInt64ParsePanic(t *testing.T) {
+func TestInt64ParseError(t *testing.T) {
 	testCases := []string{
 		"fourty two",
 		"wow!",
@@ -31,7 +33,8 @@ func TestInt64ParsePanic(t *testing.T) {
 	}
 
 	for _, input := range testCases {
-		assert.Panics(t, func() { panicParseInt64ish(input) })
+		_, err := parseInt64ish(input)
+		assert.Error(t, err)
 	}
 }
 
@@ -42,12 +45,13 @@ func TestDateParse(t *testing.T) {
 	}
 
 	for input, expectedResult := range testCases {
-		actualResult := panicParseDate(input)
+		actualResult, err := parseDate(input)
+		require.NoError(t, err)
 		assert.Equal(t, actualResult, expectedResult)
 	}
 }
 
-func TestDateParsePanic(t *testing.T) {
+func TestDateParseError(t *testing.T) {
 	testCases := []string{
 		"Jan 1, 2020",
 		"12/31/2019",
@@ -56,7 +60,8 @@ func TestDateParsePanic(t *testing.T) {
 	}
 
 	for _, input := range testCases {
-		assert.Panics(t, func() { panicParseDate(input) })
+		_, err := parseDate(input)
+		assert.Error(t, err)
 	}
 }
 
@@ -0,0 +1,131 @@
+package alphavantage
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// InsiderAction acquisition or disposal of securities
+type InsiderAction string
+
+// Insider actions
+const (
+	InsiderAcquisition InsiderAction = "A"
+	InsiderDisposal    InsiderAction = "D"
+)
+
+// InsiderTransaction security transaction of a company insider
+type InsiderTransaction struct {
+	TransactionDate       Date          `json:"transactionDate"`
+	Ticker                string        `json:"ticker"`
+	Executive             string        `json:"executive"`
+	ExecutiveTitle        string        `json:"executiveTitle"`
+	SecurityType          string        `json:"securityType"`
+	AcquisitionOrDisposal InsiderAction `json:"acquisitionOrDisposal"`
+	Shares                NullDecimal   `json:"shares"`
+	SharePrice            NullDecimal   `json:"sharePrice"`
+}
+
+// InsiderActivity insider transactions of a symbol summed up, shares and values are approximate
+type InsiderActivity struct {
+	Symbol         string  `json:"symbol"`
+	Transactions   int     `json:"transactions"`
+	SharesAcquired float64 `json:"sharesAcquired"`
+	SharesDisposed float64 `json:"sharesDisposed"`
+	// NetShares is positive for net buying and negative for net selling
+	NetShares float64 `json:"netShares"`
+	// NetValue is NetShares valued at the transaction prices, transactions without price are not valued
+	NetValue float64 `json:"netValue"`
+}
+
+// InsiderTransactions makes API request and returns parsed response
+func (c *Client) InsiderTransactions(ctx context.Context, symbol string) ([]InsiderTransaction, error) {
+	response := rawInsiderTransactionsResponse{}
+	if err := c.request(ctx, "INSIDER_TRANSACTIONS", symbolParams(symbol), &response); err != nil {
+		return nil, errors.Wrap(err, "InsiderTransactions error")
+	}
+	res := make([]InsiderTransaction, 0, len(response.Data))
+	var parseErrs ParseErrors
+	for _, raw := range response.Data {
+		tx, err := fromInsiderTransaction(raw, c.collectParseErrors)
+		if err != nil {
+			if parseErrs.collect(err) {
+				continue
+			}
+			return nil, errors.Wrap(err, "InsiderTransactions parsing error")
+		}
+		res = append(res, tx)
+	}
+	if len(parseErrs) > 0 {
+		return nil, errors.Wrap(parseErrs, "InsiderTransactions parsing error")
+	}
+	return res, nil
+}
+
+// NetInsiderActivity sums up transactions made between from and to inclusive by symbol;
+// zero from or to leaves the window open
+func NetInsiderActivity(transactions []InsiderTransaction, from Date, to Date) map[string]InsiderActivity {
+	res := map[string]InsiderActivity{}
+	for _, tx := range transactions {
+		date := time.Time(tx.TransactionDate)
+		if from.Valid() && date.Before(time.Time(from)) {
+			continue
+		}
+		if to.Valid() && date.After(time.Time(to)) {
+			continue
+		}
+		shares := tx.Shares.ValueOrZero().Float64()
+		if tx.AcquisitionOrDisposal == InsiderDisposal {
+			shares = -shares
+		} else if tx.AcquisitionOrDisposal != InsiderAcquisition {
+			continue
+		}
+
+		activity := res[tx.Ticker]
+		activity.Symbol = tx.Ticker
+		activity.Transactions++
+		if shares > 0 {
+			activity.SharesAcquired += shares
+		} else {
+			activity.SharesDisposed -= shares
+		}
+		activity.NetShares += shares
+		if tx.SharePrice.Valid {
+			activity.NetValue += shares * tx.SharePrice.Float64()
+		}
+		res[tx.Ticker] = activity
+	}
+	return res
+}
+
+type rawInsiderTransactionsResponse struct {
+	Data []rawInsiderTransaction `json:"data"`
+}
+
+type rawInsiderTransaction struct {
+	TransactionDate       string `json:"transaction_date"`
+	Ticker                string `json:"ticker"`
+	Executive             string `json:"executive"`
+	ExecutiveTitle        string `json:"executive_title"`
+	SecurityType          string `json:"security_type"`
+	AcquisitionOrDisposal string `json:"acquisition_or_disposal"`
+	Shares                string `json:"shares"`
+	SharePrice            string `json:"share_price"`
+}
+
+func fromInsiderTransaction(raw rawInsiderTransaction, collectAll bool) (InsiderTransaction, error) {
+	p := newFieldParser("InsiderTransaction", raw.TransactionDate, collectAll)
+	res := InsiderTransaction{
+		TransactionDate:       p.date("transaction_date", raw.TransactionDate),
+		Ticker:                raw.Ticker,
+		Executive:             raw.Executive,
+		ExecutiveTitle:        raw.ExecutiveTitle,
+		SecurityType:          raw.SecurityType,
+		AcquisitionOrDisposal: InsiderAction(raw.AcquisitionOrDisposal),
+		Shares:                p.nullDecimal("shares", raw.Shares),
+		SharePrice:            p.nullDecimal("share_price", raw.SharePrice),
+	}
+	return res, p.err()
+}
@@ -0,0 +1,119 @@
+package alphavantage
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testInsiderTransactions = []byte(`
+{
+	"data": [
+		{
+			"transaction_date": "2024-03-01",
+			"ticker": "IBM",
+			"executive": "KAVANAUGH, JAMES J",
+			"executive_title": "SVP & CFO",
+			"security_type": "Common Stock",
+			"acquisition_or_disposal": "D",
+			"shares": "5000.0",
+			"share_price": "190.5"
+		},
+		{
+			"transaction_date": "2024-02-15",
+			"ticker": "IBM",
+			"executive": "KRISHNA, ARVIND",
+			"executive_title": "Chairman & CEO",
+			"security_type": "Restricted Stock Unit",
+			"acquisition_or_disposal": "A",
+			"shares": "12000.0",
+			"share_price": ""
+		},
+		{
+			"transaction_date": "2024-01-10",
+			"ticker": "IBM",
+			"executive": "KAVANAUGH, JAMES J",
+			"executive_title": "SVP & CFO",
+			"security_type": "Common Stock",
+			"acquisition_or_disposal": "A",
+			"shares": "1000.0",
+			"share_price": "160.0"
+		},
+		{
+			"transaction_date": "2024-02-20",
+			"ticker": "MSFT",
+			"executive": "DOE, JANE",
+			"executive_title": "Director",
+			"security_type": "Common Stock",
+			"acquisition_or_disposal": "D",
+			"shares": "100",
+			"share_price": "400.25"
+		}
+	]
+}`)
+
+func TestInsiderTransactions(t *testing.T) {
+	httpClient := &fakeHTTPClient{StatusCode: http.StatusOK, Result: testInsiderTransactions}
+	client := NewClient(WithHTTPClient(httpClient), WithAPIKey("demo"))
+
+	res, err := client.InsiderTransactions(context.TODO(), "IBM")
+	require.NoError(t, err)
+	assert.Equal(t, "https://www.alphavantage.co/query?function=INSIDER_TRANSACTIONS&symbol=IBM&apikey=demo", httpClient.Request.URL.String())
+
+	require.Len(t, res, 4)
+	tx := res[0]
+	assert.Equal(t, "2024-03-01", tx.TransactionDate.String())
+	assert.Equal(t, "IBM", tx.Ticker)
+	assert.Equal(t, "KAVANAUGH, JAMES J", tx.Executive)
+	assert.Equal(t, "SVP & CFO", tx.ExecutiveTitle)
+	assert.Equal(t, "Common Stock", tx.SecurityType)
+	assert.Equal(t, InsiderDisposal, tx.AcquisitionOrDisposal)
+	assert.Equal(t, "5000.0", tx.Shares.String())
+	assert.Equal(t, "190.5", tx.SharePrice.String())
+	assert.Equal(t, InsiderAcquisition, res[1].AcquisitionOrDisposal)
+	assert.False(t, res[1].SharePrice.Valid)
+}
+
+func TestInsiderTransactionsParseError(t *testing.T) {
+	httpClient := &fakeHTTPClient{StatusCode: http.StatusOK, Result: []byte(`{"data": [{"transaction_date": "2024-03-01", "ticker": "IBM", "acquisition_or_disposal": "D", "shares": "5,000"}]}`)}
+	client := NewClient(WithHTTPClient(httpClient))
+
+	_, err := client.InsiderTransactions(context.TODO(), "IBM")
+	require.Error(t, err)
+	var parseErr *ParseError
+	require.True(t, errors.As(err, &parseErr))
+	assert.Equal(t, "InsiderTransaction", parseErr.Statement)
+	assert.Equal(t, "2024-03-01", parseErr.Record)
+	assert.Equal(t, "shares", parseErr.Field)
+}
+
+func TestNetInsiderActivity(t *testing.T) {
+	client := NewClient(WithHTTPClient(&fakeHTTPClient{StatusCode: http.StatusOK, Result: testInsiderTransactions}))
+	transactions, err := client.InsiderTransactions(context.TODO(), "IBM")
+	require.NoError(t, err)
+
+	res := NetInsiderActivity(transactions, Date{}, Date{})
+	require.Len(t, res, 2)
+	assert.Equal(t, InsiderActivity{
+		Symbol:         "IBM",
+		Transactions:   3,
+		SharesAcquired: 13000,
+		SharesDisposed: 5000,
+		NetShares:      8000,
+		NetValue:       -5000*190.5 + 1000*160,
+	}, res["IBM"])
+	assert.Equal(t, -100.0, res["MSFT"].NetShares)
+
+	from, err := parseDate("2024-02-01")
+	require.NoError(t, err)
+	to, err := parseDate("2024-02-20")
+	require.NoError(t, err)
+	res = NetInsiderActivity(transactions, from, to)
+	assert.Equal(t, InsiderActivity{Symbol: "IBM", Transactions: 1, SharesAcquired: 12000, NetShares: 12000}, res["IBM"])
+	assert.Equal(t, 1, res["MSFT"].Transactions)
+	assert.Equal(t, -40025.0, res["MSFT"].NetValue)
+}
@@ -0,0 +1,213 @@
+package alphavantage
+
+import (
+	"context"
+	"net/url"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	intradayLayout = "2006-01-02 15:04:05"
+	monthLayout    = "2006-01"
+	// intradayTimeZone is used for CSV responses, which do not carry "Meta Data"
+	intradayTimeZone = "US/Eastern"
+)
+
+// Interval time between two consecutive intraday bars
+type Interval string
+
+// Supported intraday intervals
+const (
+	Interval1Min  Interval = "1min"
+	Interval5Min  Interval = "5min"
+	Interval15Min Interval = "15min"
+	Interval30Min Interval = "30min"
+	Interval60Min Interval = "60min"
+)
+
+// IntradayOptions parameters of intraday time series requests, Interval is required
+type IntradayOptions struct {
+	Interval Interval
+	// Unadjusted requests raw prices instead of split and dividend adjusted ones
+	Unadjusted bool
+	// ExcludeExtendedHours limits bars to regular trading hours 9:30am to 4:00pm US Eastern Time
+	ExcludeExtendedHours bool
+	// Month requests history of a past month, e.g. "2009-01"
+	Month      string
+	OutputSize OutputSize
+	DataType   DataType
+}
+
+// valid reports whether alphavantage supports the interval
+func (i Interval) valid() bool {
+	switch i {
+	case Interval1Min, Interval5Min, Interval15Min, Interval30Min, Interval60Min:
+		return true
+	}
+	return false
+}
+
+func (o IntradayOptions) params(symbol string) (url.Values, error) {
+	if !o.Interval.valid() {
+		return nil, errors.Errorf("Unsupported interval '%s'", o.Interval)
+	}
+	params := symbolParams(symbol)
+	params.Set("interval", string(o.Interval))
+	if o.Unadjusted {
+		params.Set("adjusted", strconv.FormatBool(false))
+	}
+	if o.ExcludeExtendedHours {
+		params.Set("extended_hours", strconv.FormatBool(false))
+	}
+	if o.Month != "" {
+		if _, err := time.Parse(monthLayout, o.Month); err != nil {
+			return nil, errors.Errorf("Month '%s' does not match YYYY-MM", o.Month)
+		}
+		params.Set("month", o.Month)
+	}
+	if o.OutputSize != "" {
+		params.Set("outputsize", string(o.OutputSize))
+	}
+	if o.DataType != "" {
+		params.Set("datatype", string(o.DataType))
+	}
+	return params, nil
+}
+
+// IntradayBar open, high, low, close prices and volume of a single intraday interval
+type IntradayBar struct {
+	// Time is the interval timestamp in the time zone of the response Meta Data
+	Time   time.Time `json:"time"`
+	Open   Decimal   `json:"open"`
+	High   Decimal   `json:"high"`
+	Low    Decimal   `json:"low"`
+	Close  Decimal   `json:"close"`
+	Volume int64     `json:"volume"`
+}
+
+// IntradayTimeSeries intraday bars in chronological order
+type IntradayTimeSeries struct {
+	Metadata TimeSeriesMetadata `json:"metadata"`
+	Bars     []IntradayBar      `json:"bars"`
+}
+
+// TimeSeriesIntraday makes API request and returns parsed response
+func (c *Client) TimeSeriesIntraday(ctx context.Context, symbol string, opts IntradayOptions) (IntradayTimeSeries, error) {
+	params, err := opts.params(symbol)
+	if err != nil {
+		return IntradayTimeSeries{}, errors.Wrap(err, "TimeSeriesIntraday error")
+	}
+	if isCSV(params) {
+		return c.intradayCSV(ctx, params)
+	}
+	response := rawTimeSeriesResponse{}
+	if err := c.request(ctx, "TIME_SERIES_INTRADAY", params, &response); err != nil {
+		return IntradayTimeSeries{}, errors.Wrap(err, "TimeSeriesIntraday error")
+	}
+	res, err := fromIntradayResponse(response, c.collectParseErrors)
+	if err != nil {
+		return IntradayTimeSeries{}, errors.Wrap(err, "TimeSeriesIntraday parsing error")
+	}
+	return res, nil
+}
+
+func (c *Client) intradayCSV(ctx context.Context, params url.Values) (IntradayTimeSeries, error) {
+	res := IntradayTimeSeries{Metadata: TimeSeriesMetadata{
+		Symbol:   params.Get("symbol"),
+		Interval: params.Get("interval"),
+		TimeZone: intradayTimeZone,
+	}}
+	loc, err := time.LoadLocation(intradayTimeZone)
+	if err != nil {
+		return IntradayTimeSeries{}, errors.Wrapf(err, "Cannot load time zone '%s'", intradayTimeZone)
+	}
+	var parseErrs ParseErrors
+	err = c.requestCSV(ctx, "TIME_SERIES_INTRADAY", params, func(row map[string]string) error {
+		b, err := fromIntradayBar(row["timestamp"], row, loc, c.collectParseErrors)
+		if err != nil {
+			if parseErrs.collect(err) {
+				return nil
+			}
+			return errors.Wrap(err, "TimeSeriesIntraday parsing error")
+		}
+		res.Bars = append(res.Bars, b)
+		return nil
+	})
+	if err != nil {
+		return IntradayTimeSeries{}, errors.Wrap(err, "TimeSeriesIntraday error")
+	}
+	if len(parseErrs) > 0 {
+		return IntradayTimeSeries{}, errors.Wrap(parseErrs, "TimeSeriesIntraday parsing error")
+	}
+	sortIntradayBars(res.Bars)
+	return res, nil
+}
+
+// IntradayHistory requests every month between from and to one by one and returns all the bars in chronological order.
+// Configure the client with WithLimiter to stay within the rate limits, a full year takes 12 requests.
+func (c *Client) IntradayHistory(ctx context.Context, symbol string, from time.Time, to time.Time, opts IntradayOptions) (IntradayTimeSeries, error) {
+	res := IntradayTimeSeries{}
+	opts.OutputSize = OutputSizeFull
+	month := time.Date(from.Year(), from.Month(), 1, 0, 0, 0, 0, time.UTC)
+	last := time.Date(to.Year(), to.Month(), 1, 0, 0, 0, 0, time.UTC)
+	for ; !month.After(last); month = month.AddDate(0, 1, 0) {
+		opts.Month = month.Format(monthLayout)
+		series, err := c.TimeSeriesIntraday(ctx, symbol, opts)
+		if err != nil {
+			return IntradayTimeSeries{}, errors.Wrapf(err, "IntradayHistory %s error", opts.Month)
+		}
+		res.Metadata = series.Metadata
+		res.Bars = append(res.Bars, series.Bars...)
+	}
+	return res, nil
+}
+
+func fromIntradayResponse(response rawTimeSeriesResponse, collectAll bool) (IntradayTimeSeries, error) {
+	res := IntradayTimeSeries{
+		Metadata: response.metadata(),
+		Bars:     make([]IntradayBar, 0, len(response.Series)),
+	}
+	var parseErrs ParseErrors
+	loc, err := time.LoadLocation(res.Metadata.TimeZone)
+	if err != nil {
+		return IntradayTimeSeries{}, errors.Wrapf(err, "Cannot load time zone '%s'", res.Metadata.TimeZone)
+	}
+	for timestamp, fields := range response.Series {
+		b, err := fromIntradayBar(timestamp, fields, loc, collectAll)
+		if err != nil {
+			if parseErrs.collect(err) {
+				continue
+			}
+			return IntradayTimeSeries{}, err
+		}
+		res.Bars = append(res.Bars, b)
+	}
+	if len(parseErrs) > 0 {
+		return IntradayTimeSeries{}, parseErrs
+	}
+	sortIntradayBars(res.Bars)
+	return res, nil
+}
+
+func sortIntradayBars(bars []IntradayBar) {
+	sort.Slice(bars, func(i, j int) bool {
+		return bars[i].Time.Before(bars[j].Time)
+	})
+}
+
+func fromIntradayBar(timestamp string, fields map[string]string, loc *time.Location, collectAll bool) (IntradayBar, error) {
+	p := newFieldParser("IntradayBar", timestamp, collectAll)
+	res := IntradayBar{
+		Time:   p.time("time", timestamp, intradayLayout, loc),
+		Open:   p.decimal("open", fields["open"]),
+		High:   p.decimal("high", fields["high"]),
+		Low:    p.decimal("low", fields["low"]),
+		Close:  p.decimal("close", fields["close"]),
+		Volume: p.int64("volume", fields["volume"]),
+	}
+	return res, p.err()
+}
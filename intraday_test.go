@@ -0,0 +1,134 @@
+package alphavantage
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testIntradayResponse(first string, second string) []byte {
+	return []byte(fmt.Sprintf(`
+	{
+		"Meta Data": {
+			"1. Information": "Intraday (5min) open, high, low, close prices and volume",
+			"2. Symbol": "IBM",
+			"3. Last Refreshed": "%[2]s",
+			"4. Interval": "5min",
+			"5. Output Size": "Full size",
+			"6. Time Zone": "US/Eastern"
+		},
+		"Time Series (5min)": {
+			"%[2]s": {
+				"1. open": "125.2100",
+				"2. high": "125.2700",
+				"3. low": "125.1800",
+				"4. close": "125.2700",
+				"5. volume": "4127"
+			},
+			"%[1]s": {
+				"1. open": "125.0000",
+				"2. high": "125.2500",
+				"3. low": "124.9900",
+				"4. close": "125.2100",
+				"5. volume": "2005"
+			}
+		}
+	}`, first, second))
+}
+
+func TestTimeSeriesIntraday(t *testing.T) {
+	httpClient := &fakeHTTPClient{StatusCode: http.StatusOK, Result: testIntradayResponse("2020-08-14 19:50:00", "2020-08-14 19:55:00")}
+	client := NewClient(WithHTTPClient(httpClient), WithAPIKey("demo"))
+
+	res, err := client.TimeSeriesIntraday(context.TODO(), "IBM", IntradayOptions{
+		Interval:             Interval5Min,
+		Unadjusted:           true,
+		ExcludeExtendedHours: true,
+		Month:                "2020-08",
+		OutputSize:           OutputSizeFull,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "https://www.alphavantage.co/query?function=TIME_SERIES_INTRADAY&adjusted=false&extended_hours=false&interval=5min&month=2020-08&outputsize=full&symbol=IBM&apikey=demo", httpClient.Request.URL.String())
+	assert.Equal(t, "5min", res.Metadata.Interval)
+
+	require.Len(t, res.Bars, 2)
+	eastern, err := time.LoadLocation("US/Eastern")
+	require.NoError(t, err)
+	assert.True(t, time.Date(2020, 8, 14, 19, 50, 0, 0, eastern).Equal(res.Bars[0].Time))
+	assert.Equal(t, "2020-08-14T23:55:00Z", res.Bars[1].Time.UTC().Format(time.RFC3339))
+	assert.Equal(t, "US/Eastern", res.Bars[1].Time.Location().String())
+	assert.Equal(t, "125.2100", res.Bars[1].Open.String())
+	assert.Equal(t, int64(4127), res.Bars[1].Volume)
+}
+
+func TestTimeSeriesIntradayInvalidOptions(t *testing.T) {
+	httpClient := &fakeHTTPClient{StatusCode: http.StatusOK}
+	client := NewClient(WithHTTPClient(httpClient))
+
+	_, err := client.TimeSeriesIntraday(context.TODO(), "IBM", IntradayOptions{Interval: "2min"})
+	assert.Error(t, err)
+	_, err = client.TimeSeriesIntraday(context.TODO(), "IBM", IntradayOptions{Interval: Interval1Min, Month: "2020-8-1"})
+	assert.Error(t, err)
+	assert.Nil(t, httpClient.Request)
+}
+
+func TestTimeSeriesIntradayParseError(t *testing.T) {
+	httpClient := &fakeHTTPClient{StatusCode: http.StatusOK, Result: testIntradayResponse("2020-08-14T19:50", "2020-08-14 19:55:00")}
+	client := NewClient(WithHTTPClient(httpClient))
+
+	_, err := client.TimeSeriesIntraday(context.TODO(), "IBM", IntradayOptions{Interval: Interval5Min})
+	require.Error(t, err)
+	var parseErr *ParseError
+	require.True(t, errors.As(err, &parseErr))
+	assert.Equal(t, "IntradayBar", parseErr.Statement)
+	assert.Equal(t, "time", parseErr.Field)
+	assert.Equal(t, "2020-08-14T19:50", parseErr.Value)
+}
+
+func TestIntradayHistory(t *testing.T) {
+	var months []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		month := r.URL.Query().Get("month")
+		months = append(months, month)
+		assert.Equal(t, "full", r.URL.Query().Get("outputsize"))
+		_, _ = w.Write(testIntradayResponse(month+"-02 09:30:00", month+"-02 09:35:00"))
+	}))
+	defer server.Close()
+
+	client := NewClient(WithBaseURL(server.URL), WithHTTPClient(server.Client()))
+	from := time.Date(2019, 11, 20, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2020, 2, 3, 0, 0, 0, 0, time.UTC)
+
+	res, err := client.IntradayHistory(context.TODO(), "IBM", from, to, IntradayOptions{Interval: Interval5Min})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"2019-11", "2019-12", "2020-01", "2020-02"}, months)
+	require.Len(t, res.Bars, 8)
+	for i := 1; i < len(res.Bars); i++ {
+		assert.True(t, res.Bars[i-1].Time.Before(res.Bars[i].Time))
+	}
+}
+
+func TestTimeSeriesIntradayCSVMatchesJSON(t *testing.T) {
+	jsonClient := NewClient(WithHTTPClient(&fakeHTTPClient{StatusCode: http.StatusOK, Result: testIntradayResponse("2020-08-14 19:50:00", "2020-08-14 19:55:00")}))
+	csvHTTPClient := &fakeHTTPClient{StatusCode: http.StatusOK, Result: []byte("timestamp,open,high,low,close,volume\r\n" +
+		"2020-08-14 19:55:00,125.2100,125.2700,125.1800,125.2700,4127\r\n" +
+		"2020-08-14 19:50:00,125.0000,125.2500,124.9900,125.2100,2005\r\n")}
+	csvClient := NewClient(WithHTTPClient(csvHTTPClient))
+
+	fromJSON, err := jsonClient.TimeSeriesIntraday(context.TODO(), "IBM", IntradayOptions{Interval: Interval5Min})
+	require.NoError(t, err)
+	fromCSV, err := csvClient.TimeSeriesIntraday(context.TODO(), "IBM", IntradayOptions{Interval: Interval5Min, DataType: DataTypeCSV})
+	require.NoError(t, err)
+	assert.Equal(t, "csv", csvHTTPClient.Request.URL.Query().Get("datatype"))
+
+	assert.Equal(t, TimeSeriesMetadata{Symbol: "IBM", Interval: "5min", TimeZone: "US/Eastern"}, fromCSV.Metadata)
+	require.Len(t, fromCSV.Bars, 2)
+	assert.Equal(t, fromJSON.Bars, fromCSV.Bars)
+}
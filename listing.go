@@ -0,0 +1,85 @@
+package alphavantage
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/pkg/errors"
+)
+
+// ListingState selects active or delisted symbols
+type ListingState string
+
+// Supported listing states
+const (
+	ListingStateActive   ListingState = "active"
+	ListingStateDelisted ListingState = "delisted"
+)
+
+// ListingStatusOptions optional parameters of LISTING_STATUS requests, the zero value lists symbols active today
+type ListingStatusOptions struct {
+	// Date requests symbols listed or delisted as of the date, any date later than 2010-01-01 is supported
+	Date  Date
+	State ListingState
+}
+
+func (o ListingStatusOptions) params() url.Values {
+	params := url.Values{}
+	if o.Date.Valid() {
+		params.Set("date", o.Date.String())
+	}
+	if o.State != "" {
+		params.Set("state", string(o.State))
+	}
+	return params
+}
+
+// Listing listed or delisted symbol
+type Listing struct {
+	Symbol    string `json:"symbol"`
+	Name      string `json:"name"`
+	Exchange  string `json:"exchange"`
+	AssetType string `json:"assetType"`
+	IPODate   Date   `json:"ipoDate"`
+	// DelistingDate is zero for active symbols
+	DelistingDate Date   `json:"delistingDate"`
+	Status        string `json:"status"`
+}
+
+// ListingStatus makes API request and returns parsed response
+func (c *Client) ListingStatus(ctx context.Context, opts ListingStatusOptions) ([]Listing, error) {
+	var res []Listing
+	var parseErrs ParseErrors
+	err := c.requestCSV(ctx, "LISTING_STATUS", opts.params(), func(row map[string]string) error {
+		l, err := fromListing(row, c.collectParseErrors)
+		if err != nil {
+			if parseErrs.collect(err) {
+				return nil
+			}
+			return errors.Wrap(err, "ListingStatus parsing error")
+		}
+		res = append(res, l)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "ListingStatus error")
+	}
+	if len(parseErrs) > 0 {
+		return nil, errors.Wrap(parseErrs, "ListingStatus parsing error")
+	}
+	return res, nil
+}
+
+func fromListing(row map[string]string, collectAll bool) (Listing, error) {
+	p := newFieldParser("Listing", row["symbol"], collectAll)
+	res := Listing{
+		Symbol:        row["symbol"],
+		Name:          row["name"],
+		Exchange:      row["exchange"],
+		AssetType:     row["assetType"],
+		IPODate:       p.date("ipoDate", row["ipoDate"]),
+		DelistingDate: p.date("delistingDate", row["delistingDate"]),
+		Status:        row["status"],
+	}
+	return res, p.err()
+}
@@ -0,0 +1,58 @@
+package alphavantage
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestListingStatus(t *testing.T) {
+	httpClient := &fakeHTTPClient{StatusCode: http.StatusOK, Result: []byte("symbol,name,exchange,assetType,ipoDate,delistingDate,status\r\n" +
+		"A,Agilent Technologies Inc,NYSE,Stock,1999-11-18,null,Active\r\n" +
+		"AAA,AXS FIRST PRIORITY CLO BOND ETF ,NYSE ARCA,ETF,2020-09-09,,Active\r\n")}
+	client := NewClient(WithHTTPClient(httpClient), WithAPIKey("demo"))
+
+	date, err := parseDate("2014-07-10")
+	require.NoError(t, err)
+	res, err := client.ListingStatus(context.TODO(), ListingStatusOptions{Date: date, State: ListingStateActive})
+	require.NoError(t, err)
+	assert.Equal(t, "https://www.alphavantage.co/query?function=LISTING_STATUS&date=2014-07-10&state=active&apikey=demo", httpClient.Request.URL.String())
+
+	require.Len(t, res, 2)
+	assert.Equal(t, "A", res[0].Symbol)
+	assert.Equal(t, "Agilent Technologies Inc", res[0].Name)
+	assert.Equal(t, "NYSE", res[0].Exchange)
+	assert.Equal(t, "Stock", res[0].AssetType)
+	assert.Equal(t, "1999-11-18", res[0].IPODate.String())
+	assert.False(t, res[0].DelistingDate.Valid())
+	assert.Equal(t, "Active", res[0].Status)
+	assert.Equal(t, "AXS FIRST PRIORITY CLO BOND ETF", res[1].Name)
+	assert.False(t, res[1].DelistingDate.Valid())
+}
+
+func TestListingStatusParseError(t *testing.T) {
+	httpClient := &fakeHTTPClient{StatusCode: http.StatusOK, Result: []byte("symbol,name,exchange,assetType,ipoDate,delistingDate,status\r\n" +
+		"AAC,Ares Acquisition Corp,NYSE,Stock,2021-03-25,11/06/2023,Delisted\r\n")}
+	client := NewClient(WithHTTPClient(httpClient))
+
+	_, err := client.ListingStatus(context.TODO(), ListingStatusOptions{State: ListingStateDelisted})
+	require.Error(t, err)
+	var parseErr *ParseError
+	require.True(t, errors.As(err, &parseErr))
+	assert.Equal(t, "Listing", parseErr.Statement)
+	assert.Equal(t, "AAC", parseErr.Record)
+	assert.Equal(t, "delistingDate", parseErr.Field)
+}
+
+func TestListingStatusSoftError(t *testing.T) {
+	httpClient := &fakeHTTPClient{StatusCode: http.StatusOK, Result: []byte(`{"Error Message": "Invalid API call."}`)}
+	client := NewClient(WithHTTPClient(httpClient))
+
+	_, err := client.ListingStatus(context.TODO(), ListingStatusOptions{})
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrInvalidSymbol))
+}
@@ -0,0 +1,144 @@
+package alphavantage
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const localTimeLayout = "15:04"
+
+// regionTimeZones IANA time zones of MARKET_STATUS regions
+var regionTimeZones = map[string]string{
+	"United States":  "America/New_York",
+	"Canada":         "America/Toronto",
+	"Mexico":         "America/Mexico_City",
+	"Brazil":         "America/Sao_Paulo",
+	"United Kingdom": "Europe/London",
+	"Germany":        "Europe/Berlin",
+	"France":         "Europe/Paris",
+	"Spain":          "Europe/Madrid",
+	"Portugal":       "Europe/Lisbon",
+	"Japan":          "Asia/Tokyo",
+	"India":          "Asia/Kolkata",
+	"Mainland China": "Asia/Shanghai",
+	"Hong Kong":      "Asia/Hong_Kong",
+	"South Africa":   "Africa/Johannesburg",
+	"Global":         "UTC",
+}
+
+// Market trading hours and current status of a market
+type Market struct {
+	// MarketType is "Equity", "Forex" or "Cryptocurrency"
+	MarketType       string   `json:"marketType"`
+	Region           string   `json:"region"`
+	PrimaryExchanges []string `json:"primaryExchanges"`
+	// LocalOpen and LocalClose are local trading hours, e.g. "09:30", or "N/A" for round the clock markets
+	LocalOpen  string `json:"localOpen"`
+	LocalClose string `json:"localClose"`
+	// CurrentStatus is "open" or "closed" at the time of the request
+	CurrentStatus string `json:"currentStatus"`
+	Notes         string `json:"notes"`
+}
+
+// Markets MARKET_STATUS results
+type Markets []Market
+
+// MarketStatus makes API request and returns parsed response
+func (c *Client) MarketStatus(ctx context.Context) (Markets, error) {
+	response := rawMarketStatusResponse{}
+	if err := c.request(ctx, "MARKET_STATUS", url.Values{}, &response); err != nil {
+		return nil, errors.Wrap(err, "MarketStatus error")
+	}
+	res := make(Markets, 0, len(response.Markets))
+	for _, raw := range response.Markets {
+		res = append(res, fromMarket(raw))
+	}
+	return res, nil
+}
+
+// Find returns market of marketType in region, comparison is case-insensitive
+func (m Markets) Find(marketType string, region string) (Market, bool) {
+	for _, market := range m {
+		if strings.EqualFold(market.MarketType, marketType) && strings.EqualFold(market.Region, region) {
+			return market, true
+		}
+	}
+	return Market{}, false
+}
+
+// IsOpenAt reports whether the market trades at t according to its local trading hours.
+// Weekends are closed, holidays and lunch breaks are not taken into account;
+// markets with "N/A" hours, e.g. forex, are open around the clock on weekdays;
+// cryptocurrency markets are always open.
+func (m Market) IsOpenAt(t time.Time) (bool, error) {
+	if strings.EqualFold(m.MarketType, "Cryptocurrency") {
+		return true, nil
+	}
+	zone, ok := regionTimeZones[m.Region]
+	if !ok {
+		return false, errors.Errorf("Unknown time zone of region '%s'", m.Region)
+	}
+	loc, err := time.LoadLocation(zone)
+	if err != nil {
+		return false, errors.Wrapf(err, "Cannot load time zone '%s'", zone)
+	}
+	local := t.In(loc)
+	if local.Weekday() == time.Saturday || local.Weekday() == time.Sunday {
+		return false, nil
+	}
+	if isRoundTheClock(m.LocalOpen) && isRoundTheClock(m.LocalClose) {
+		return true, nil
+	}
+	open, err := time.Parse(localTimeLayout, m.LocalOpen)
+	if err != nil {
+		return false, errors.Wrapf(err, "Cannot parse %s %s open time '%s'", m.Region, m.MarketType, m.LocalOpen)
+	}
+	closing, err := time.Parse(localTimeLayout, m.LocalClose)
+	if err != nil {
+		return false, errors.Wrapf(err, "Cannot parse %s %s close time '%s'", m.Region, m.MarketType, m.LocalClose)
+	}
+
+	minutes := local.Hour()*60 + local.Minute()
+	return minutes >= open.Hour()*60+open.Minute() && minutes < closing.Hour()*60+closing.Minute(), nil
+}
+
+// isRoundTheClock reports whether local trading hour is "N/A"
+func isRoundTheClock(v string) bool {
+	return strings.EqualFold(strings.TrimSpace(v), "N/A")
+}
+
+type rawMarketStatusResponse struct {
+	Markets []rawMarket `json:"markets"`
+}
+
+type rawMarket struct {
+	MarketType       string `json:"market_type"`
+	Region           string `json:"region"`
+	PrimaryExchanges string `json:"primary_exchanges"`
+	LocalOpen        string `json:"local_open"`
+	LocalClose       string `json:"local_close"`
+	CurrentStatus    string `json:"current_status"`
+	Notes            string `json:"notes"`
+}
+
+func fromMarket(raw rawMarket) Market {
+	var exchanges []string
+	for _, exchange := range strings.Split(raw.PrimaryExchanges, ",") {
+		if exchange = strings.TrimSpace(exchange); exchange != "" {
+			exchanges = append(exchanges, exchange)
+		}
+	}
+	return Market{
+		MarketType:       raw.MarketType,
+		Region:           raw.Region,
+		PrimaryExchanges: exchanges,
+		LocalOpen:        raw.LocalOpen,
+		LocalClose:       raw.LocalClose,
+		CurrentStatus:    raw.CurrentStatus,
+		Notes:            raw.Notes,
+	}
+}
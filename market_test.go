@@ -0,0 +1,122 @@
+package alphavantage
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testMarketStatus = []byte(`
+{
+	"endpoint": "Global Market Open & Close Status",
+	"markets": [
+		{
+			"market_type": "Equity",
+			"region": "United States",
+			"primary_exchanges": "NASDAQ, NYSE, AMEX, BATS",
+			"local_open": "09:30",
+			"local_close": "16:15",
+			"current_status": "open",
+			"notes": ""
+		},
+		{
+			"market_type": "Equity",
+			"region": "Japan",
+			"primary_exchanges": "Tokyo",
+			"local_open": "09:00",
+			"local_close": "15:00",
+			"current_status": "closed",
+			"notes": ""
+		},
+		{
+			"market_type": "Forex",
+			"region": "Global",
+			"primary_exchanges": "Global",
+			"local_open": "N/A",
+			"local_close": "N/A",
+			"current_status": "open",
+			"notes": ""
+		},
+		{
+			"market_type": "Cryptocurrency",
+			"region": "Global",
+			"primary_exchanges": "Global",
+			"local_open": "N/A",
+			"local_close": "N/A",
+			"current_status": "open",
+			"notes": ""
+		}
+	]
+}`)
+
+func TestMarketStatus(t *testing.T) {
+	httpClient := &fakeHTTPClient{StatusCode: http.StatusOK, Result: testMarketStatus}
+	client := NewClient(WithHTTPClient(httpClient), WithAPIKey("demo"))
+
+	res, err := client.MarketStatus(context.TODO())
+	require.NoError(t, err)
+	assert.Equal(t, "https://www.alphavantage.co/query?function=MARKET_STATUS&apikey=demo", httpClient.Request.URL.String())
+	require.Len(t, res, 4)
+	assert.Equal(t, Market{
+		MarketType:       "Equity",
+		Region:           "United States",
+		PrimaryExchanges: []string{"NASDAQ", "NYSE", "AMEX", "BATS"},
+		LocalOpen:        "09:30",
+		LocalClose:       "16:15",
+		CurrentStatus:    "open",
+	}, res[0])
+
+	japan, ok := res.Find("equity", "japan")
+	require.True(t, ok)
+	assert.Equal(t, []string{"Tokyo"}, japan.PrimaryExchanges)
+	_, ok = res.Find("Equity", "Atlantis")
+	assert.False(t, ok)
+}
+
+func TestMarketIsOpenAt(t *testing.T) {
+	client := NewClient(WithHTTPClient(&fakeHTTPClient{StatusCode: http.StatusOK, Result: testMarketStatus}))
+	markets, err := client.MarketStatus(context.TODO())
+	require.NoError(t, err)
+	us, _ := markets.Find("Equity", "United States")
+	japan, _ := markets.Find("Equity", "Japan")
+	crypto, _ := markets.Find("Cryptocurrency", "Global")
+	forex, _ := markets.Find("Forex", "Global")
+
+	testCases := []struct {
+		market   Market
+		t        time.Time
+		expected bool
+	}{
+		// Friday 2023-09-01 09:30 EDT
+		{us, time.Date(2023, 9, 1, 13, 30, 0, 0, time.UTC), true},
+		{us, time.Date(2023, 9, 1, 13, 29, 0, 0, time.UTC), false},
+		{us, time.Date(2023, 9, 1, 20, 14, 0, 0, time.UTC), true},
+		{us, time.Date(2023, 9, 1, 20, 15, 0, 0, time.UTC), false},
+		// Saturday
+		{us, time.Date(2023, 9, 2, 15, 0, 0, 0, time.UTC), false},
+		// Monday 10:00 JST
+		{japan, time.Date(2023, 9, 4, 1, 0, 0, 0, time.UTC), true},
+		// Sunday 23:30 in UTC is Monday 08:30 JST
+		{japan, time.Date(2023, 9, 3, 23, 30, 0, 0, time.UTC), false},
+		{japan, time.Date(2023, 9, 4, 7, 0, 0, 0, time.UTC), false},
+		{crypto, time.Date(2023, 9, 2, 15, 0, 0, 0, time.UTC), true},
+		// forex trades around the clock on weekdays
+		{forex, time.Date(2023, 9, 1, 3, 0, 0, 0, time.UTC), true},
+		{forex, time.Date(2023, 9, 1, 23, 59, 0, 0, time.UTC), true},
+		{forex, time.Date(2023, 9, 2, 12, 0, 0, 0, time.UTC), false},
+	}
+	for _, testCase := range testCases {
+		actual, err := testCase.market.IsOpenAt(testCase.t)
+		require.NoError(t, err)
+		assert.Equal(t, testCase.expected, actual, "%s %s", testCase.market.Region, testCase.t)
+	}
+
+	_, err = Market{MarketType: "Equity", Region: "United States", LocalOpen: "N/A", LocalClose: "16:00"}.IsOpenAt(time.Date(2023, 9, 1, 15, 0, 0, 0, time.UTC))
+	assert.Error(t, err)
+	_, err = Market{MarketType: "Equity", Region: "Atlantis", LocalOpen: "09:00", LocalClose: "17:00"}.IsOpenAt(time.Now())
+	assert.Error(t, err)
+}
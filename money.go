@@ -2,6 +2,8 @@ package alphavantage
 
 import (
 	"fmt"
+	"math"
+	"math/big"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -9,6 +11,9 @@ import (
 
 const nullJSONString string = `"null"`
 
+// moneyScale Money stores 4 implied decimal places
+const moneyScale = 10000
+
 // Money just money type
 type Money int64
 
@@ -29,14 +34,15 @@ func normalizeExp(v string) string {
 	}
 }
 
-// panicParseMoney parses alphavantage money types
-func panicParseMoney(value string) Money {
+// parseAPIMoney parses alphavantage money fields leniently, "None", "nil" and empty values are parsed as zero;
+// use ParseMoney to parse user input
+func parseAPIMoney(value string) (Money, error) {
 	if value == "" || value == "None" || value == "nil" {
-		return 0
+		return 0, nil
 	}
 	parts := strings.Split(value, ".")
 	if len(parts) != 1 && len(parts) != 2 {
-		panic(errors.Errorf("Cannot parse money type '%s'", value))
+		return 0, errors.Errorf("Cannot parse money type '%s'", value)
 	}
 
 	exp := ""
@@ -45,20 +51,28 @@ func panicParseMoney(value string) Money {
 	}
 	exp = normalizeExp(exp)
 	if exp == "" {
-		panic(errors.Errorf("Cannot parse money type '%s'", value))
+		return 0, errors.Errorf("Cannot parse money type '%s'", value)
+	}
+	res, err := parseInt64ish(parts[0] + exp)
+	if err != nil {
+		return 0, errors.Wrapf(err, "Cannot parse money type '%s'", value)
 	}
-	return Money(panicParseInt64ish(parts[0] + exp))
+	return Money(res), nil
 }
 
 // UnmarshalJSON decodes DateKey
-func (m *Money) UnmarshalJSON(b []byte) (err error) {
+func (m *Money) UnmarshalJSON(b []byte) error {
 	s := strings.Trim(string(b), "\"")
 	if s == nullJSONString {
 		*m = 0
-		return
+		return nil
 	}
-	*m = panicParseMoney(s)
-	return
+	res, err := parseAPIMoney(s)
+	if err != nil {
+		return err
+	}
+	*m = res
+	return nil
 }
 
 // MarshalJSON converts DailyRawResponse to json string
@@ -73,3 +87,188 @@ func (m Money) String() string {
 	last4 := s[len(s)-4:]
 	return fmt.Sprintf(`%s.%s`, strings.ReplaceAll(first, "+", ""), last4)
 }
+
+// RoundingMode defines how values which do not fit into Money precision are rounded
+type RoundingMode int
+
+const (
+	// RoundHalfEven rounds to nearest, ties to even (banker's rounding)
+	RoundHalfEven RoundingMode = iota
+	// RoundHalfUp rounds to nearest, ties away from zero
+	RoundHalfUp
+	// RoundTruncate rounds toward zero
+	RoundTruncate
+)
+
+// ErrMoneyOverflow result does not fit into Money
+var ErrMoneyOverflow = errors.New("money overflow")
+
+// ParseMoney parses user input like "1,234.56", "-0.5" or "1.5e3"; fails if there are more than 4 decimal places
+func ParseMoney(value string) (Money, error) {
+	d, err := ParseDecimal(strings.ReplaceAll(strings.TrimSpace(value), ",", ""))
+	if err != nil {
+		return 0, errors.Wrapf(err, "Cannot parse money '%s'", value)
+	}
+	return d.Money()
+}
+
+// ParseMoneyRounded parses user input like ParseMoney, rounding extra decimal places with mode
+func ParseMoneyRounded(value string, mode RoundingMode) (Money, error) {
+	d, err := ParseDecimal(strings.ReplaceAll(strings.TrimSpace(value), ",", ""))
+	if err != nil {
+		return 0, errors.Wrapf(err, "Cannot parse money '%s'", value)
+	}
+	return d.RoundMoney(mode)
+}
+
+// RoundMoney converts Decimal to Money rounding extra decimal places with mode
+func (d Decimal) RoundMoney(mode RoundingMode) (Money, error) {
+	scaled := new(big.Rat).Mul(d.Rat(), new(big.Rat).SetInt64(moneyScale))
+	return moneyFromRat(scaled, mode)
+}
+
+// Add returns m + o
+func (m Money) Add(o Money) (Money, error) {
+	res := m + o
+	if (o > 0 && res < m) || (o < 0 && res > m) {
+		return 0, errors.Wrapf(ErrMoneyOverflow, "%s + %s", m, o)
+	}
+	return res, nil
+}
+
+// Sub returns m - o
+func (m Money) Sub(o Money) (Money, error) {
+	res := m - o
+	if (o > 0 && res > m) || (o < 0 && res < m) {
+		return 0, errors.Wrapf(ErrMoneyOverflow, "%s - %s", m, o)
+	}
+	return res, nil
+}
+
+// Neg returns -m
+func (m Money) Neg() (Money, error) {
+	if m == math.MinInt64 {
+		return 0, errors.Wrapf(ErrMoneyOverflow, "-(%s)", m)
+	}
+	return -m, nil
+}
+
+// Abs returns |m|
+func (m Money) Abs() (Money, error) {
+	if m < 0 {
+		return m.Neg()
+	}
+	return m, nil
+}
+
+// MulInt returns m * n
+func (m Money) MulInt(n int64) (Money, error) {
+	res := new(big.Int).Mul(big.NewInt(int64(m)), big.NewInt(n))
+	if !res.IsInt64() {
+		return 0, errors.Wrapf(ErrMoneyOverflow, "%s * %d", m, n)
+	}
+	return Money(res.Int64()), nil
+}
+
+// DivInt returns m / n rounded with mode
+func (m Money) DivInt(n int64, mode RoundingMode) (Money, error) {
+	if n == 0 {
+		return 0, errors.Errorf("Cannot divide %s by zero", m)
+	}
+	return moneyFromRat(big.NewRat(int64(m), n), mode)
+}
+
+// MulRat returns m * r rounded with mode, use Decimal.Rat() to multiply by a decimal ratio
+func (m Money) MulRat(r *big.Rat, mode RoundingMode) (Money, error) {
+	return moneyFromRat(new(big.Rat).Mul(new(big.Rat).SetInt64(int64(m)), r), mode)
+}
+
+// DivRat returns m / r rounded with mode
+func (m Money) DivRat(r *big.Rat, mode RoundingMode) (Money, error) {
+	if r.Sign() == 0 {
+		return 0, errors.Errorf("Cannot divide %s by zero", m)
+	}
+	return moneyFromRat(new(big.Rat).Quo(new(big.Rat).SetInt64(int64(m)), r), mode)
+}
+
+// Round rounds m to places decimal places (0 to 4) with mode
+func (m Money) Round(places int, mode RoundingMode) (Money, error) {
+	if places < 0 || places > 4 {
+		return 0, errors.Errorf("Cannot round money to %d decimal places", places)
+	}
+	unit := int64(math.Pow10(4 - places))
+	units, err := moneyFromRat(big.NewRat(int64(m), unit), mode)
+	if err != nil {
+		return 0, err
+	}
+	return units.MulInt(unit)
+}
+
+// Cmp compares m and o, returns -1, 0 or +1
+func (m Money) Cmp(o Money) int {
+	switch {
+	case m < o:
+		return -1
+	case m > o:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// Sign returns -1, 0 or +1
+func (m Money) Sign() int {
+	return m.Cmp(0)
+}
+
+// IsZero reports whether m is 0
+func (m Money) IsZero() bool {
+	return m == 0
+}
+
+// IsNegative reports whether m is less than 0
+func (m Money) IsNegative() bool {
+	return m < 0
+}
+
+// LessThan reports whether m < o
+func (m Money) LessThan(o Money) bool {
+	return m < o
+}
+
+// GreaterThan reports whether m > o
+func (m Money) GreaterThan(o Money) bool {
+	return m > o
+}
+
+// Float64 converts Money to float64
+func (m Money) Float64() float64 {
+	return float64(m) / moneyScale
+}
+
+// moneyFromRat rounds r (already scaled by moneyScale) to integer with mode
+func moneyFromRat(r *big.Rat, mode RoundingMode) (Money, error) {
+	res := roundRat(r, mode)
+	if !res.IsInt64() {
+		return 0, errors.Wrap(ErrMoneyOverflow, "Cannot convert to money")
+	}
+	return Money(res.Int64()), nil
+}
+
+// roundRat rounds r to integer with mode
+func roundRat(r *big.Rat, mode RoundingMode) *big.Int {
+	quo, rem := new(big.Int).QuoRem(r.Num(), r.Denom(), new(big.Int))
+	if rem.Sign() == 0 || mode == RoundTruncate {
+		return quo
+	}
+
+	// compare the remainder with a half of denominator
+	half := new(big.Int).Mul(new(big.Int).Abs(rem), big.NewInt(2)).Cmp(r.Denom())
+	awayFromZero := half > 0 ||
+		(half == 0 && mode == RoundHalfUp) ||
+		(half == 0 && mode == RoundHalfEven && quo.Bit(0) == 1)
+	if awayFromZero {
+		quo.Add(quo, big.NewInt(int64(r.Sign())))
+	}
+	return quo
+}
@@ -1,8 +1,11 @@
 package alphavantage
 
 import (
+	"math"
+	"math/big"
 	"testing"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -41,12 +44,13 @@ func TestMoneyParse(t *testing.T) {
 	}
 
 	for input, expectedResult := range testCases {
-		actualResult := panicParseMoney(input)
+		actualResult, err := parseAPIMoney(input)
+		require.NoError(t, err)
 		assert.Equal(t, expectedResult, int64(actualResult))
 	}
 }
 
-func TestMoneyParsePanic(t *testing.T) {
+func TestMoneyParseError(t *testing.T) {
 	testCases := []string{
 		"fourty two",
 		"one third",
@@ -54,7 +58,8 @@ func TestMoneyParsePanic(t *testing.T) {
 	}
 
 	for _, input := range testCases {
-		assert.Panics(t, func() { panicParseMoney(input) })
+		_, err := parseAPIMoney(input)
+		assert.Error(t, err)
 	}
 }
 
@@ -71,7 +76,8 @@ func TestMoneyToString(t *testing.T) {
 	}
 
 	for input, expectedResult := range testCases {
-		actualResult := panicParseMoney(input)
+		actualResult, err := parseAPIMoney(input)
+		require.NoError(t, err)
 		assert.Equal(t, expectedResult, actualResult.String())
 	}
 }
@@ -89,8 +95,168 @@ func TestMoneyMarshalJSON(t *testing.T) {
 	}
 
 	for input, expectedResult := range testCases {
-		actualResult, err := panicParseMoney(input).MarshalJSON()
+		m, err := parseAPIMoney(input)
+		require.NoError(t, err)
+		actualResult, err := m.MarshalJSON()
 		require.NoError(t, err)
 		assert.Equal(t, expectedResult, string(actualResult))
 	}
 }
+
+func TestParseMoney(t *testing.T) {
+	testCases := map[string]Money{
+		"0":          0,
+		"1,234.56":   12345600,
+		" -0.5 ":     -5000,
+		"1.5e3":      15000000,
+		"+.0001":     1,
+		"28282828.0": 282828280000,
+	}
+
+	for input, expectedResult := range testCases {
+		actualResult, err := ParseMoney(input)
+		require.NoError(t, err, input)
+		assert.Equal(t, expectedResult, actualResult, input)
+	}
+
+	for _, input := range []string{"", "None", "1.23456", "one", "1e30"} {
+		_, err := ParseMoney(input)
+		assert.Error(t, err, input)
+	}
+}
+
+func TestParseMoneyRounded(t *testing.T) {
+	testCases := map[RoundingMode]map[string]Money{
+		RoundHalfEven: {
+			"0.00005":  0,
+			"0.00015":  2,
+			"0.000151": 2,
+			"-0.00015": -2,
+			"0.05251":  525,
+		},
+		RoundHalfUp: {
+			"0.00005":  1,
+			"0.00015":  2,
+			"-0.00005": -1,
+			"0.000149": 1,
+		},
+		RoundTruncate: {
+			"0.00009":  0,
+			"-0.00019": -1,
+			"0.05259":  525,
+		},
+	}
+
+	for mode, inputs := range testCases {
+		for input, expectedResult := range inputs {
+			actualResult, err := ParseMoneyRounded(input, mode)
+			require.NoError(t, err, input)
+			assert.Equal(t, expectedResult, actualResult, "%s mode %d", input, mode)
+		}
+	}
+}
+
+func TestMoneyArithmetic(t *testing.T) {
+	res, err := Money(15000).Add(Money(2500))
+	require.NoError(t, err)
+	assert.Equal(t, Money(17500), res)
+
+	res, err = Money(15000).Sub(Money(25000))
+	require.NoError(t, err)
+	assert.Equal(t, Money(-10000), res)
+
+	res, err = Money(-15000).Abs()
+	require.NoError(t, err)
+	assert.Equal(t, Money(15000), res)
+
+	res, err = Money(15000).Neg()
+	require.NoError(t, err)
+	assert.Equal(t, Money(-15000), res)
+
+	res, err = Money(15000).MulInt(-3)
+	require.NoError(t, err)
+	assert.Equal(t, Money(-45000), res)
+
+	res, err = Money(10000).DivInt(3, RoundHalfEven)
+	require.NoError(t, err)
+	assert.Equal(t, Money(3333), res)
+
+	res, err = Money(20000).DivInt(3, RoundHalfUp)
+	require.NoError(t, err)
+	assert.Equal(t, Money(6667), res)
+
+	res, err = Money(20000).DivInt(3, RoundTruncate)
+	require.NoError(t, err)
+	assert.Equal(t, Money(6666), res)
+
+	res, err = Money(1000000).MulRat(big.NewRat(525, 10000), RoundHalfEven)
+	require.NoError(t, err)
+	assert.Equal(t, Money(52500), res)
+
+	res, err = Money(1000000).DivRat(big.NewRat(3, 2), RoundHalfUp)
+	require.NoError(t, err)
+	assert.Equal(t, Money(666667), res)
+
+	_, err = Money(1).DivInt(0, RoundHalfEven)
+	assert.Error(t, err)
+	_, err = Money(1).DivRat(new(big.Rat), RoundHalfEven)
+	assert.Error(t, err)
+}
+
+func TestMoneyOverflow(t *testing.T) {
+	_, err := Money(math.MaxInt64).Add(1)
+	assert.True(t, errors.Is(err, ErrMoneyOverflow))
+	_, err = Money(math.MinInt64).Sub(1)
+	assert.True(t, errors.Is(err, ErrMoneyOverflow))
+	_, err = Money(math.MinInt64).Neg()
+	assert.True(t, errors.Is(err, ErrMoneyOverflow))
+	_, err = Money(math.MinInt64).Abs()
+	assert.True(t, errors.Is(err, ErrMoneyOverflow))
+	_, err = Money(math.MaxInt64 / 2).MulInt(3)
+	assert.True(t, errors.Is(err, ErrMoneyOverflow))
+	_, err = Money(math.MaxInt64).MulRat(big.NewRat(3, 2), RoundHalfEven)
+	assert.True(t, errors.Is(err, ErrMoneyOverflow))
+}
+
+func TestMoneyRound(t *testing.T) {
+	testCases := map[int]Money{
+		4: 12345,
+		3: 12340,
+		2: 12300,
+		1: 12000,
+		0: 10000,
+	}
+	for places, expectedResult := range testCases {
+		actualResult, err := Money(12345).Round(places, RoundHalfEven)
+		require.NoError(t, err)
+		assert.Equal(t, expectedResult, actualResult, places)
+	}
+
+	actualResult, err := Money(12500).Round(2, RoundHalfEven)
+	require.NoError(t, err)
+	assert.Equal(t, Money(12500), actualResult)
+	actualResult, err = Money(250).Round(2, RoundHalfEven)
+	require.NoError(t, err)
+	assert.Equal(t, Money(200), actualResult)
+	actualResult, err = Money(250).Round(2, RoundHalfUp)
+	require.NoError(t, err)
+	assert.Equal(t, Money(300), actualResult)
+	actualResult, err = Money(-150).Round(2, RoundTruncate)
+	require.NoError(t, err)
+	assert.Equal(t, Money(-100), actualResult)
+
+	_, err = Money(1).Round(5, RoundHalfEven)
+	assert.Error(t, err)
+}
+
+func TestMoneyComparison(t *testing.T) {
+	assert.Equal(t, -1, Money(1).Cmp(2))
+	assert.Equal(t, 0, Money(2).Cmp(2))
+	assert.Equal(t, 1, Money(3).Cmp(2))
+	assert.Equal(t, -1, Money(-3).Sign())
+	assert.True(t, Money(0).IsZero())
+	assert.True(t, Money(-1).IsNegative())
+	assert.True(t, Money(1).LessThan(2))
+	assert.True(t, Money(3).GreaterThan(2))
+	assert.Equal(t, 1.2345, Money(12345).Float64())
+}
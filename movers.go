@@ -0,0 +1,104 @@
+package alphavantage
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// MarketMovers top gainers, losers and most actively traded US tickers
+type MarketMovers struct {
+	Metadata           string    `json:"metadata"`
+	LastUpdated        time.Time `json:"lastUpdated"`
+	TopGainers         []Mover   `json:"topGainers"`
+	TopLosers          []Mover   `json:"topLosers"`
+	MostActivelyTraded []Mover   `json:"mostActivelyTraded"`
+}
+
+// Mover price change of a ticker
+type Mover struct {
+	Ticker       string  `json:"ticker"`
+	Price        Decimal `json:"price"`
+	ChangeAmount Decimal `json:"changeAmount"`
+	// ChangePercentage is given in percent, e.g. 232.5 for "232.5%"
+	ChangePercentage Decimal `json:"changePercentage"`
+	Volume           int64   `json:"volume"`
+}
+
+// TopGainersLosers makes API request and returns parsed response
+func (c *Client) TopGainersLosers(ctx context.Context) (MarketMovers, error) {
+	response := rawMarketMoversResponse{}
+	if err := c.request(ctx, "TOP_GAINERS_LOSERS", url.Values{}, &response); err != nil {
+		return MarketMovers{}, errors.Wrap(err, "TopGainersLosers error")
+	}
+	res, err := fromMarketMovers(response, c.collectParseErrors)
+	if err != nil {
+		return MarketMovers{}, errors.Wrap(err, "TopGainersLosers parsing error")
+	}
+	return res, nil
+}
+
+// parseZonedTime parses "2006-01-02 15:04:05 US/Eastern" timestamps with trailing IANA time zone name
+func parseZonedTime(v string) (time.Time, error) {
+	i := strings.LastIndex(v, " ")
+	if i < 0 {
+		return time.Time{}, errors.Errorf("Cannot parse '%s': time zone is missing", v)
+	}
+	loc, err := time.LoadLocation(v[i+1:])
+	if err != nil {
+		return time.Time{}, errors.Wrapf(err, "Cannot parse '%s'", v)
+	}
+	res, err := time.ParseInLocation(intradayLayout, v[:i], loc)
+	if err != nil {
+		return time.Time{}, errors.Wrapf(err, "Cannot parse '%s'", v)
+	}
+	return res, nil
+}
+
+type rawMarketMoversResponse struct {
+	Metadata           string     `json:"metadata"`
+	LastUpdated        string     `json:"last_updated"`
+	TopGainers         []rawMover `json:"top_gainers"`
+	TopLosers          []rawMover `json:"top_losers"`
+	MostActivelyTraded []rawMover `json:"most_actively_traded"`
+}
+
+type rawMover struct {
+	Ticker           string `json:"ticker"`
+	Price            string `json:"price"`
+	ChangeAmount     string `json:"change_amount"`
+	ChangePercentage string `json:"change_percentage"`
+	Volume           string `json:"volume"`
+}
+
+func fromMarketMovers(raw rawMarketMoversResponse, collectAll bool) (MarketMovers, error) {
+	p := newFieldParser("MarketMovers", raw.LastUpdated, collectAll)
+	res := MarketMovers{Metadata: raw.Metadata}
+	lastUpdated, err := parseZonedTime(raw.LastUpdated)
+	if err != nil {
+		p.fail("last_updated", raw.LastUpdated, err)
+	}
+	res.LastUpdated = lastUpdated
+	res.TopGainers = fromMovers(p, "top_gainers", raw.TopGainers)
+	res.TopLosers = fromMovers(p, "top_losers", raw.TopLosers)
+	res.MostActivelyTraded = fromMovers(p, "most_actively_traded", raw.MostActivelyTraded)
+	return res, p.err()
+}
+
+func fromMovers(p *fieldParser, list string, raw []rawMover) []Mover {
+	res := make([]Mover, 0, len(raw))
+	for _, m := range raw {
+		prefix := list + "." + m.Ticker + "."
+		res = append(res, Mover{
+			Ticker:           m.Ticker,
+			Price:            p.decimal(prefix+"price", m.Price),
+			ChangeAmount:     p.decimal(prefix+"change_amount", m.ChangeAmount),
+			ChangePercentage: p.decimal(prefix+"change_percentage", strings.TrimSuffix(m.ChangePercentage, "%")),
+			Volume:           p.int64(prefix+"volume", m.Volume),
+		})
+	}
+	return res
+}
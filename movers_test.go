@@ -0,0 +1,84 @@
+package alphavantage
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTopGainersLosers(t *testing.T) {
+	httpClient := &fakeHTTPClient{StatusCode: http.StatusOK, Result: []byte(`
+	{
+		"metadata": "Top gainers, losers, and most actively traded US tickers",
+		"last_updated": "2023-09-01 16:15:59 US/Eastern",
+		"top_gainers": [
+			{"ticker": "SNTG", "price": "4.74", "change_amount": "2.69", "change_percentage": "131.2195%", "volume": "62006087"}
+		],
+		"top_losers": [
+			{"ticker": "NUZE", "price": "0.081", "change_amount": "-0.0905", "change_percentage": "-52.7697%", "volume": "2542817"}
+		],
+		"most_actively_traded": [
+			{"ticker": "TSLA", "price": "245.01", "change_amount": "-13.07", "change_percentage": "-5.0643%", "volume": "132272530"},
+			{"ticker": "NVDA", "price": "485.09", "change_amount": "-8.46", "change_percentage": "-1.7141%", "volume": "51012617"}
+		]
+	}`)}
+	client := NewClient(WithHTTPClient(httpClient), WithAPIKey("demo"))
+
+	res, err := client.TopGainersLosers(context.TODO())
+	require.NoError(t, err)
+	assert.Equal(t, "https://www.alphavantage.co/query?function=TOP_GAINERS_LOSERS&apikey=demo", httpClient.Request.URL.String())
+	assert.Equal(t, "Top gainers, losers, and most actively traded US tickers", res.Metadata)
+	assert.Equal(t, "2023-09-01T20:15:59Z", res.LastUpdated.UTC().Format(time.RFC3339))
+	assert.Equal(t, "US/Eastern", res.LastUpdated.Location().String())
+
+	require.Len(t, res.TopGainers, 1)
+	assert.Equal(t, "SNTG", res.TopGainers[0].Ticker)
+	assert.Equal(t, "4.74", res.TopGainers[0].Price.String())
+	assert.Equal(t, "2.69", res.TopGainers[0].ChangeAmount.String())
+	assert.Equal(t, "131.2195", res.TopGainers[0].ChangePercentage.String())
+	assert.Equal(t, int64(62006087), res.TopGainers[0].Volume)
+
+	require.Len(t, res.TopLosers, 1)
+	assert.Equal(t, "-52.7697", res.TopLosers[0].ChangePercentage.String())
+	assert.Equal(t, "0.081", res.TopLosers[0].Price.String())
+
+	require.Len(t, res.MostActivelyTraded, 2)
+	assert.Equal(t, "NVDA", res.MostActivelyTraded[1].Ticker)
+	assert.Equal(t, int64(51012617), res.MostActivelyTraded[1].Volume)
+}
+
+func TestTopGainersLosersParseError(t *testing.T) {
+	httpClient := &fakeHTTPClient{StatusCode: http.StatusOK, Result: []byte(`
+	{
+		"last_updated": "2023-09-01 16:15:59 US/Eastern",
+		"top_gainers": [{"ticker": "SNTG", "price": "4.74", "change_amount": "2.69", "change_percentage": "n/a", "volume": "62006087"}]
+	}`)}
+	client := NewClient(WithHTTPClient(httpClient))
+
+	_, err := client.TopGainersLosers(context.TODO())
+	require.Error(t, err)
+	var parseErr *ParseError
+	require.True(t, errors.As(err, &parseErr))
+	assert.Equal(t, "MarketMovers", parseErr.Statement)
+	assert.Equal(t, "top_gainers.SNTG.change_percentage", parseErr.Field)
+}
+
+func TestParseZonedTime(t *testing.T) {
+	res, err := parseZonedTime("2023-09-01 16:15:59 US/Eastern")
+	require.NoError(t, err)
+	assert.Equal(t, "2023-09-01T16:15:59-04:00", res.Format(time.RFC3339))
+
+	res, err = parseZonedTime("2023-12-01 16:15:59 America/New_York")
+	require.NoError(t, err)
+	assert.Equal(t, "2023-12-01T16:15:59-05:00", res.Format(time.RFC3339))
+
+	for _, input := range []string{"", "2023-09-01", "2023-09-01 16:15:59 Mars/Olympus", "2023-09-01T16:15:59 UTC"} {
+		_, err := parseZonedTime(input)
+		assert.Error(t, err, input)
+	}
+}
@@ -0,0 +1,219 @@
+package alphavantage
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	newsTimeLayout      = "20060102T150405"
+	newsTimeParamLayout = "20060102T1504"
+)
+
+// NewsSort order of NEWS_SENTIMENT articles
+type NewsSort string
+
+// Supported news orders
+const (
+	NewsSortLatest    NewsSort = "LATEST"
+	NewsSortEarliest  NewsSort = "EARLIEST"
+	NewsSortRelevance NewsSort = "RELEVANCE"
+)
+
+// NewsSentimentOptions optional parameters of NEWS_SENTIMENT requests, the zero value returns the latest 50 articles
+type NewsSentimentOptions struct {
+	// Tickers returns articles mentioning all the tickers, e.g. "IBM", "CRYPTO:BTC" or "FOREX:USD"
+	Tickers []string
+	// Topics returns articles covering all the topics, e.g. "technology" or "earnings"
+	Topics   []string
+	TimeFrom time.Time
+	TimeTo   time.Time
+	Sort     NewsSort
+	// Limit is up to 1000 articles
+	Limit int
+}
+
+func (o NewsSentimentOptions) params() url.Values {
+	params := url.Values{}
+	if len(o.Tickers) > 0 {
+		params.Set("tickers", strings.Join(o.Tickers, ","))
+	}
+	if len(o.Topics) > 0 {
+		params.Set("topics", strings.Join(o.Topics, ","))
+	}
+	if !o.TimeFrom.IsZero() {
+		params.Set("time_from", o.TimeFrom.UTC().Format(newsTimeParamLayout))
+	}
+	if !o.TimeTo.IsZero() {
+		params.Set("time_to", o.TimeTo.UTC().Format(newsTimeParamLayout))
+	}
+	if o.Sort != "" {
+		params.Set("sort", string(o.Sort))
+	}
+	if o.Limit > 0 {
+		params.Set("limit", strconv.Itoa(o.Limit))
+	}
+	return params
+}
+
+// NewsArticle news article with its sentiment
+type NewsArticle struct {
+	Title string `json:"title"`
+	URL   string `json:"url"`
+	// TimePublished is in UTC
+	TimePublished        time.Time   `json:"timePublished"`
+	Authors              []string    `json:"authors"`
+	Summary              string      `json:"summary"`
+	BannerImage          string      `json:"bannerImage"`
+	Source               string      `json:"source"`
+	CategoryWithinSource string      `json:"categoryWithinSource"`
+	SourceDomain         string      `json:"sourceDomain"`
+	Topics               []NewsTopic `json:"topics"`
+	// OverallSentimentScore is between -1 (bearish) and 1 (bullish)
+	OverallSentimentScore float64           `json:"overallSentimentScore"`
+	OverallSentimentLabel string            `json:"overallSentimentLabel"`
+	TickerSentiment       []TickerSentiment `json:"tickerSentiment"`
+}
+
+// NewsTopic topic of an article
+type NewsTopic struct {
+	Topic string `json:"topic"`
+	// RelevanceScore is between 0 and 1
+	RelevanceScore float64 `json:"relevanceScore"`
+}
+
+// TickerSentiment sentiment of an article towards a ticker
+type TickerSentiment struct {
+	Ticker string `json:"ticker"`
+	// RelevanceScore is between 0 and 1
+	RelevanceScore float64 `json:"relevanceScore"`
+	// SentimentScore is between -1 (bearish) and 1 (bullish)
+	SentimentScore float64 `json:"sentimentScore"`
+	SentimentLabel string  `json:"sentimentLabel"`
+}
+
+// SentimentSummary average sentiment towards a ticker
+type SentimentSummary struct {
+	Ticker string `json:"ticker"`
+	// Articles is the number of articles mentioning the ticker
+	Articles     int     `json:"articles"`
+	AverageScore float64 `json:"averageScore"`
+}
+
+// NewsSentiment makes API request and returns parsed response
+func (c *Client) NewsSentiment(ctx context.Context, opts NewsSentimentOptions) ([]NewsArticle, error) {
+	response := rawNewsSentimentResponse{}
+	if err := c.request(ctx, "NEWS_SENTIMENT", opts.params(), &response); err != nil {
+		return nil, errors.Wrap(err, "NewsSentiment error")
+	}
+	res := make([]NewsArticle, 0, len(response.Feed))
+	var parseErrs ParseErrors
+	for _, raw := range response.Feed {
+		a, err := fromNewsArticle(raw, c.collectParseErrors)
+		if err != nil {
+			if parseErrs.collect(err) {
+				continue
+			}
+			return nil, errors.Wrap(err, "NewsSentiment parsing error")
+		}
+		res = append(res, a)
+	}
+	if len(parseErrs) > 0 {
+		return nil, errors.Wrap(parseErrs, "NewsSentiment parsing error")
+	}
+	return res, nil
+}
+
+// AverageTickerSentiment averages ticker sentiment of articles published in [from, to); zero from or to leaves
+// the window open
+func AverageTickerSentiment(articles []NewsArticle, from time.Time, to time.Time) map[string]SentimentSummary {
+	res := map[string]SentimentSummary{}
+	for _, article := range articles {
+		if !from.IsZero() && article.TimePublished.Before(from) {
+			continue
+		}
+		if !to.IsZero() && !article.TimePublished.Before(to) {
+			continue
+		}
+		for _, sentiment := range article.TickerSentiment {
+			summary := res[sentiment.Ticker]
+			summary.Ticker = sentiment.Ticker
+			summary.AverageScore = (summary.AverageScore*float64(summary.Articles) + sentiment.SentimentScore) / float64(summary.Articles+1)
+			summary.Articles++
+			res[sentiment.Ticker] = summary
+		}
+	}
+	return res
+}
+
+type rawNewsSentimentResponse struct {
+	Feed []rawNewsArticle `json:"feed"`
+}
+
+type rawNewsArticle struct {
+	Title                 string               `json:"title"`
+	URL                   string               `json:"url"`
+	TimePublished         string               `json:"time_published"`
+	Authors               []string             `json:"authors"`
+	Summary               string               `json:"summary"`
+	BannerImage           string               `json:"banner_image"`
+	Source                string               `json:"source"`
+	CategoryWithinSource  string               `json:"category_within_source"`
+	SourceDomain          string               `json:"source_domain"`
+	Topics                []rawNewsTopic       `json:"topics"`
+	OverallSentimentScore json.RawMessage      `json:"overall_sentiment_score"`
+	OverallSentimentLabel string               `json:"overall_sentiment_label"`
+	TickerSentiment       []rawTickerSentiment `json:"ticker_sentiment"`
+}
+
+type rawNewsTopic struct {
+	Topic          string `json:"topic"`
+	RelevanceScore string `json:"relevance_score"`
+}
+
+type rawTickerSentiment struct {
+	Ticker               string `json:"ticker"`
+	RelevanceScore       string `json:"relevance_score"`
+	TickerSentimentScore string `json:"ticker_sentiment_score"`
+	TickerSentimentLabel string `json:"ticker_sentiment_label"`
+}
+
+func fromNewsArticle(raw rawNewsArticle, collectAll bool) (NewsArticle, error) {
+	p := newFieldParser("NewsArticle", raw.URL, collectAll)
+	res := NewsArticle{
+		Title:                 raw.Title,
+		URL:                   raw.URL,
+		TimePublished:         p.time("time_published", raw.TimePublished, newsTimeLayout, time.UTC),
+		Authors:               raw.Authors,
+		Summary:               raw.Summary,
+		BannerImage:           raw.BannerImage,
+		Source:                raw.Source,
+		CategoryWithinSource:  raw.CategoryWithinSource,
+		SourceDomain:          raw.SourceDomain,
+		Topics:                make([]NewsTopic, 0, len(raw.Topics)),
+		OverallSentimentScore: p.float64("overall_sentiment_score", strings.Trim(string(raw.OverallSentimentScore), "\"")),
+		OverallSentimentLabel: raw.OverallSentimentLabel,
+		TickerSentiment:       make([]TickerSentiment, 0, len(raw.TickerSentiment)),
+	}
+	for _, topic := range raw.Topics {
+		res.Topics = append(res.Topics, NewsTopic{
+			Topic:          topic.Topic,
+			RelevanceScore: p.float64("topics.relevance_score", topic.RelevanceScore),
+		})
+	}
+	for _, sentiment := range raw.TickerSentiment {
+		res.TickerSentiment = append(res.TickerSentiment, TickerSentiment{
+			Ticker:         sentiment.Ticker,
+			RelevanceScore: p.float64("ticker_sentiment.relevance_score", sentiment.RelevanceScore),
+			SentimentScore: p.float64("ticker_sentiment.ticker_sentiment_score", sentiment.TickerSentimentScore),
+			SentimentLabel: sentiment.TickerSentimentLabel,
+		})
+	}
+	return res, p.err()
+}
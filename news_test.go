@@ -0,0 +1,129 @@
+package alphavantage
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testNewsSentiment = []byte(`
+{
+	"items": "2",
+	"sentiment_score_definition": "x <= -0.35: Bearish; -0.35 < x <= -0.15: Somewhat-Bearish; -0.15 < x < 0.15: Neutral; 0.15 <= x < 0.35: Somewhat_Bullish; x >= 0.35: Bullish",
+	"relevance_score_definition": "0 < x <= 1, with a higher score indicating higher relevance.",
+	"feed": [
+		{
+			"title": "IBM Unveils New Mainframe",
+			"url": "https://example.com/ibm-mainframe",
+			"time_published": "20220410T130000",
+			"authors": ["Jane Doe", "John Roe"],
+			"summary": "IBM unveiled a new mainframe.",
+			"banner_image": "https://example.com/ibm.png",
+			"source": "Example News",
+			"category_within_source": "Markets",
+			"source_domain": "example.com",
+			"topics": [
+				{"topic": "Technology", "relevance_score": "1.0"},
+				{"topic": "Earnings", "relevance_score": "0.158519"}
+			],
+			"overall_sentiment_score": 0.256187,
+			"overall_sentiment_label": "Somewhat-Bullish",
+			"ticker_sentiment": [
+				{"ticker": "IBM", "relevance_score": "0.8", "ticker_sentiment_score": "0.4", "ticker_sentiment_label": "Bullish"},
+				{"ticker": "MSFT", "relevance_score": "0.1", "ticker_sentiment_score": "-0.1", "ticker_sentiment_label": "Neutral"}
+			]
+		},
+		{
+			"title": "IBM Misses Estimates",
+			"url": "https://example.com/ibm-earnings",
+			"time_published": "20220411T090000",
+			"authors": [],
+			"summary": "IBM missed estimates.",
+			"banner_image": "",
+			"source": "Example News",
+			"category_within_source": "n/a",
+			"source_domain": "example.com",
+			"topics": [],
+			"overall_sentiment_score": "-0.2",
+			"overall_sentiment_label": "Somewhat-Bearish",
+			"ticker_sentiment": [
+				{"ticker": "IBM", "relevance_score": "0.9", "ticker_sentiment_score": "-0.2", "ticker_sentiment_label": "Somewhat-Bearish"}
+			]
+		}
+	]
+}`)
+
+func TestNewsSentiment(t *testing.T) {
+	httpClient := &fakeHTTPClient{StatusCode: http.StatusOK, Result: testNewsSentiment}
+	client := NewClient(WithHTTPClient(httpClient), WithAPIKey("demo"))
+
+	res, err := client.NewsSentiment(context.TODO(), NewsSentimentOptions{
+		Tickers:  []string{"IBM", "MSFT"},
+		Topics:   []string{"technology"},
+		TimeFrom: time.Date(2022, 4, 10, 9, 30, 59, 0, time.UTC),
+		TimeTo:   time.Date(2022, 4, 12, 0, 0, 0, 0, time.UTC),
+		Sort:     NewsSortLatest,
+		Limit:    200,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "https://www.alphavantage.co/query?function=NEWS_SENTIMENT&limit=200&sort=LATEST&tickers=IBM%2CMSFT&time_from=20220410T0930&time_to=20220412T0000&topics=technology&apikey=demo", httpClient.Request.URL.String())
+
+	require.Len(t, res, 2)
+	a := res[0]
+	assert.Equal(t, "IBM Unveils New Mainframe", a.Title)
+	assert.Equal(t, "https://example.com/ibm-mainframe", a.URL)
+	assert.Equal(t, time.Date(2022, 4, 10, 13, 0, 0, 0, time.UTC), a.TimePublished)
+	assert.Equal(t, []string{"Jane Doe", "John Roe"}, a.Authors)
+	assert.Equal(t, "Markets", a.CategoryWithinSource)
+	assert.Equal(t, "example.com", a.SourceDomain)
+	assert.Equal(t, []NewsTopic{{Topic: "Technology", RelevanceScore: 1}, {Topic: "Earnings", RelevanceScore: 0.158519}}, a.Topics)
+	assert.Equal(t, 0.256187, a.OverallSentimentScore)
+	assert.Equal(t, "Somewhat-Bullish", a.OverallSentimentLabel)
+	assert.Equal(t, TickerSentiment{Ticker: "IBM", RelevanceScore: 0.8, SentimentScore: 0.4, SentimentLabel: "Bullish"}, a.TickerSentiment[0])
+	assert.Equal(t, -0.2, res[1].OverallSentimentScore)
+}
+
+func TestNewsSentimentParseError(t *testing.T) {
+	httpClient := &fakeHTTPClient{StatusCode: http.StatusOK, Result: []byte(`
+	{
+		"feed": [{
+			"url": "https://example.com/a",
+			"time_published": "20220410T130000",
+			"overall_sentiment_score": 0.1,
+			"ticker_sentiment": [{"ticker": "IBM", "relevance_score": "0.8", "ticker_sentiment_score": "bullish"}]
+		}]
+	}`)}
+	client := NewClient(WithHTTPClient(httpClient))
+
+	_, err := client.NewsSentiment(context.TODO(), NewsSentimentOptions{})
+	require.Error(t, err)
+	var parseErr *ParseError
+	require.True(t, errors.As(err, &parseErr))
+	assert.Equal(t, "NewsArticle", parseErr.Statement)
+	assert.Equal(t, "https://example.com/a", parseErr.Record)
+	assert.Equal(t, "ticker_sentiment.ticker_sentiment_score", parseErr.Field)
+}
+
+func TestAverageTickerSentiment(t *testing.T) {
+	client := NewClient(WithHTTPClient(&fakeHTTPClient{StatusCode: http.StatusOK, Result: testNewsSentiment}))
+	articles, err := client.NewsSentiment(context.TODO(), NewsSentimentOptions{})
+	require.NoError(t, err)
+
+	res := AverageTickerSentiment(articles, time.Time{}, time.Time{})
+	require.Len(t, res, 2)
+	assert.Equal(t, 2, res["IBM"].Articles)
+	assert.InDelta(t, 0.1, res["IBM"].AverageScore, 1e-9)
+	assert.Equal(t, SentimentSummary{Ticker: "MSFT", Articles: 1, AverageScore: -0.1}, res["MSFT"])
+
+	res = AverageTickerSentiment(articles, time.Date(2022, 4, 11, 0, 0, 0, 0, time.UTC), time.Time{})
+	assert.Equal(t, map[string]SentimentSummary{"IBM": {Ticker: "IBM", Articles: 1, AverageScore: -0.2}}, res)
+
+	res = AverageTickerSentiment(articles, time.Time{}, time.Date(2022, 4, 11, 9, 0, 0, 0, time.UTC))
+	assert.Equal(t, 0.4, res["IBM"].AverageScore)
+	assert.Equal(t, 1, res["IBM"].Articles)
+}
@@ -0,0 +1,134 @@
+package alphavantage
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// NullInt64 int64 which may be absent, e.g. "None" in alphavantage responses
+type NullInt64 struct {
+	Int64 int64
+	// Valid is false when the value is absent
+	Valid bool
+}
+
+// NewNullInt64 creates valid NullInt64
+func NewNullInt64(v int64) NullInt64 {
+	return NullInt64{Int64: v, Valid: true}
+}
+
+// parseNullInt64 parses alphavantage integer types, "None", "-" and empty values are parsed as absent
+func parseNullInt64(v string) (NullInt64, error) {
+	if isAbsentValue(v) {
+		return NullInt64{}, nil
+	}
+	res, err := strconv.ParseInt(v, 10, 64)
+	if err != nil {
+		return NullInt64{}, errors.Wrapf(err, "Cannot parse '%s'", v)
+	}
+	return NewNullInt64(res), nil
+}
+
+// ValueOrZero returns the value or 0 when absent
+func (n NullInt64) ValueOrZero() int64 {
+	return n.Int64
+}
+
+// Or returns the value or def when absent
+func (n NullInt64) Or(def int64) int64 {
+	if !n.Valid {
+		return def
+	}
+	return n.Int64
+}
+
+// String converts NullInt64 to string, absent value is converted to "None"
+func (n NullInt64) String() string {
+	if !n.Valid {
+		return "None"
+	}
+	return strconv.FormatInt(n.Int64, 10)
+}
+
+// UnmarshalJSON decodes numbers, quoted numbers and absent values
+func (n *NullInt64) UnmarshalJSON(b []byte) error {
+	res, err := parseNullInt64(strings.Trim(string(b), "\""))
+	if err != nil {
+		return err
+	}
+	*n = res
+	return nil
+}
+
+// MarshalJSON converts NullInt64 to json number, absent value is encoded as null
+func (n NullInt64) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return []byte(strconv.FormatInt(n.Int64, 10)), nil
+}
+
+// NullMoney Money which may be absent, e.g. "None" in alphavantage responses
+type NullMoney struct {
+	Money Money
+	// Valid is false when the value is absent
+	Valid bool
+}
+
+// NewNullMoney creates valid NullMoney
+func NewNullMoney(v Money) NullMoney {
+	return NullMoney{Money: v, Valid: true}
+}
+
+// parseNullMoney parses alphavantage money types, "None", "-" and empty values are parsed as absent
+func parseNullMoney(v string) (NullMoney, error) {
+	if isAbsentValue(v) {
+		return NullMoney{}, nil
+	}
+	res, err := parseAPIMoney(v)
+	if err != nil {
+		return NullMoney{}, err
+	}
+	return NewNullMoney(res), nil
+}
+
+// ValueOrZero returns the value or 0 when absent
+func (n NullMoney) ValueOrZero() Money {
+	return n.Money
+}
+
+// Or returns the value or def when absent
+func (n NullMoney) Or(def Money) Money {
+	if !n.Valid {
+		return def
+	}
+	return n.Money
+}
+
+// String converts NullMoney to string, absent value is converted to "None"
+func (n NullMoney) String() string {
+	if !n.Valid {
+		return "None"
+	}
+	return n.Money.String()
+}
+
+// UnmarshalJSON decodes money strings and absent values
+func (n *NullMoney) UnmarshalJSON(b []byte) error {
+	res, err := parseNullMoney(strings.Trim(string(b), "\""))
+	if err != nil {
+		return err
+	}
+	*n = res
+	return nil
+}
+
+// MarshalJSON converts NullMoney to json string, absent value is encoded as null
+func (n NullMoney) MarshalJSON() ([]byte, error) {
+	if !n.Valid {
+		return []byte("null"), nil
+	}
+	return n.Money.MarshalJSON()
+}
@@ -0,0 +1,99 @@
+package alphavantage
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/mkorenkov/alphavantage/formtype"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNullInt64Parse(t *testing.T) {
+	testCases := map[string]NullInt64{
+		"None":          {},
+		"-":             {},
+		"":              {},
+		"0":             NewNullInt64(0),
+		"-1":            NewNullInt64(-1),
+		"152186000000":  NewNullInt64(152186000000),
+		"-152186000000": NewNullInt64(-152186000000),
+	}
+
+	for input, expectedResult := range testCases {
+		actualResult, err := parseNullInt64(input)
+		require.NoError(t, err, input)
+		assert.Equal(t, expectedResult, actualResult, input)
+	}
+
+	_, err := parseNullInt64("wow!")
+	assert.Error(t, err)
+}
+
+func TestNullInt64JSON(t *testing.T) {
+	testCases := map[string]string{
+		`"58222000000"`: `58222000000`,
+		`58222000000`:   `58222000000`,
+		`"0"`:           `0`,
+		`"None"`:        `null`,
+		`null`:          `null`,
+	}
+
+	for input, expectedResult := range testCases {
+		var n NullInt64
+		require.NoError(t, json.Unmarshal([]byte(input), &n), input)
+		actualResult, err := json.Marshal(n)
+		require.NoError(t, err)
+		assert.Equal(t, expectedResult, string(actualResult), input)
+	}
+}
+
+func TestNullInt64Helpers(t *testing.T) {
+	assert.Equal(t, int64(0), NullInt64{}.ValueOrZero())
+	assert.Equal(t, int64(42), NullInt64{}.Or(42))
+	assert.Equal(t, int64(7), NewNullInt64(7).Or(42))
+	assert.Equal(t, "None", NullInt64{}.String())
+	assert.Equal(t, "7", NewNullInt64(7).String())
+}
+
+func TestNullMoneyJSON(t *testing.T) {
+	testCases := map[string]string{
+		`"14.0782"`: `"14.0782"`,
+		`"0"`:       `"0.0000"`,
+		`"None"`:    `null`,
+		`"-"`:       `null`,
+		`null`:      `null`,
+	}
+
+	for input, expectedResult := range testCases {
+		var n NullMoney
+		require.NoError(t, json.Unmarshal([]byte(input), &n), input)
+		actualResult, err := json.Marshal(n)
+		require.NoError(t, err)
+		assert.Equal(t, expectedResult, string(actualResult), input)
+	}
+
+	assert.Equal(t, Money(0), NullMoney{}.ValueOrZero())
+	assert.Equal(t, Money(10000), NullMoney{}.Or(10000))
+	assert.Equal(t, "None", NullMoney{}.String())
+}
+
+func TestBalanceSheetNoneIsNotZero(t *testing.T) {
+	raw := rawBalanceSheetItem{
+		FiscalDateEnding: "2019-12-31",
+		Goodwill:         "None",
+		NegativeGoodwill: "0",
+	}
+
+	statement, err := fromBalanceSheet(raw, formtype.Form10K, false)
+	require.NoError(t, err)
+	assert.False(t, statement.Goodwill.Valid)
+	assert.True(t, statement.NegativeGoodwill.Valid)
+	assert.Equal(t, int64(0), statement.NegativeGoodwill.Int64)
+
+	encoded, err := json.Marshal(statement)
+	require.NoError(t, err)
+	assert.Contains(t, string(encoded), `"goodwill":null`)
+	assert.Contains(t, string(encoded), `"negativeGoodwill":0`)
+	assert.Contains(t, string(encoded), `"fiscalDateEnding":"2019-12-31"`)
+}
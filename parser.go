@@ -0,0 +1,223 @@
+package alphavantage
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ParseError describes a field which cannot be parsed
+type ParseError struct {
+	// Statement is the type of the record, e.g. "BalanceSheet" or "Quote"
+	Statement string
+	// Record identifies the record within the response, e.g. fiscal date ending, symbol or timestamp;
+	// use it to tell failing records apart on every endpoint
+	Record string
+	// FiscalDateEnding repeats Record for financial statements and earnings and is empty for other records,
+	// it is kept for callers inspecting statement errors
+	FiscalDateEnding string
+	// Field is JSON field name
+	Field string
+	// Value is the offending raw value
+	Value string
+	// Err is the underlying parsing error
+	Err error
+}
+
+// Error implements error
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("Cannot parse %s %s field '%s' value '%s': %s", e.Statement, e.Record, e.Field, e.Value, e.Err)
+}
+
+// Unwrap returns the underlying parsing error
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// ParseErrors all field errors of a response, returned when parse error collection is enabled
+type ParseErrors []*ParseError
+
+// Error implements error
+func (e ParseErrors) Error() string {
+	messages := make([]string, 0, len(e))
+	for _, err := range e {
+		messages = append(messages, err.Error())
+	}
+	return strings.Join(messages, "; ")
+}
+
+// collect appends field errors of err to e, reports false when err is not ParseErrors
+func (e *ParseErrors) collect(err error) bool {
+	var errs ParseErrors
+	if !errors.As(err, &errs) {
+		return false
+	}
+	*e = append(*e, errs...)
+	return true
+}
+
+// WithParseErrorCollection makes the client report field errors of all the records of a response as ParseErrors
+// instead of stopping at the first one
+func WithParseErrorCollection() Option {
+	return func(c *Client) {
+		c.collectParseErrors = true
+	}
+}
+
+// fieldParser parses raw string fields of a single record, remembering errors
+type fieldParser struct {
+	statement        string
+	record           string
+	fiscalDateEnding string
+	collectAll       bool
+	errs             ParseErrors
+}
+
+func newFieldParser(statement string, record string, collectAll bool) *fieldParser {
+	return &fieldParser{
+		statement:  statement,
+		record:     record,
+		collectAll: collectAll,
+	}
+}
+
+// newStatementParser fieldParser of a financial statement identified by its fiscal date ending
+func newStatementParser(statement string, fiscalDateEnding string, collectAll bool) *fieldParser {
+	return &fieldParser{
+		statement:        statement,
+		record:           fiscalDateEnding,
+		fiscalDateEnding: fiscalDateEnding,
+		collectAll:       collectAll,
+	}
+}
+
+// skip reports whether parsing should stop because of the previous error
+func (p *fieldParser) skip() bool {
+	return !p.collectAll && len(p.errs) > 0
+}
+
+func (p *fieldParser) fail(field string, value string, err error) {
+	p.errs = append(p.errs, &ParseError{
+		Statement:        p.statement,
+		Record:           p.record,
+		FiscalDateEnding: p.fiscalDateEnding,
+		Field:            field,
+		Value:            value,
+		Err:              err,
+	})
+}
+
+func (p *fieldParser) nullInt64(field string, value string) NullInt64 {
+	if p.skip() {
+		return NullInt64{}
+	}
+	res, err := parseNullInt64(value)
+	if err != nil {
+		p.fail(field, value, err)
+	}
+	return res
+}
+
+func (p *fieldParser) date(field string, value string) Date {
+	if p.skip() {
+		return Date{}
+	}
+	res, err := parseDate(value)
+	if err != nil {
+		p.fail(field, value, err)
+	}
+	return res
+}
+
+// requiredDate parses date identifying the record, absent value is an error
+func (p *fieldParser) requiredDate(field string, value string) Date {
+	if p.skip() {
+		return Date{}
+	}
+	if isAbsentValue(value) {
+		p.fail(field, value, errors.New("Date is missing"))
+		return Date{}
+	}
+	return p.date(field, value)
+}
+
+func (p *fieldParser) int64(field string, value string) int64 {
+	if p.skip() {
+		return 0
+	}
+	res, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		p.fail(field, value, errors.Wrapf(err, "Cannot parse '%s'", value))
+	}
+	return res
+}
+
+func (p *fieldParser) float64(field string, value string) float64 {
+	if p.skip() {
+		return 0
+	}
+	res, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		p.fail(field, value, errors.Wrapf(err, "Cannot parse '%s'", value))
+	}
+	return res
+}
+
+func (p *fieldParser) decimal(field string, value string) Decimal {
+	if p.skip() {
+		return Decimal{}
+	}
+	res, err := ParseDecimal(value)
+	if err != nil {
+		p.fail(field, value, err)
+	}
+	return res
+}
+
+func (p *fieldParser) nullDecimal(field string, value string) NullDecimal {
+	if p.skip() {
+		return NullDecimal{}
+	}
+	res, err := parseNullDecimal(value)
+	if err != nil {
+		p.fail(field, value, err)
+	}
+	return res
+}
+
+func (p *fieldParser) splitRatio(field string, value string) SplitRatio {
+	if p.skip() {
+		return SplitRatio{}
+	}
+	res, err := ParseSplitRatio(value)
+	if err != nil {
+		p.fail(field, value, err)
+	}
+	return res
+}
+
+func (p *fieldParser) time(field string, value string, layout string, loc *time.Location) time.Time {
+	if p.skip() {
+		return time.Time{}
+	}
+	res, err := time.ParseInLocation(layout, value, loc)
+	if err != nil {
+		p.fail(field, value, errors.Wrapf(err, "Cannot parse '%s'", value))
+	}
+	return res
+}
+
+// err returns *ParseError, or ParseErrors when collecting all errors
+func (p *fieldParser) err() error {
+	switch {
+	case len(p.errs) == 0:
+		return nil
+	case p.collectAll:
+		return p.errs
+	default:
+		return p.errs[0]
+	}
+}
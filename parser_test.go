@@ -0,0 +1,148 @@
+package alphavantage
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/mkorenkov/alphavantage/formtype"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatementParseError(t *testing.T) {
+	raw := rawCashFlowItem{
+		FiscalDateEnding: "2019-12-31",
+		ReportedCurrency: "USD",
+		Investments:      "6988000000",
+		NetIncome:        "9431e6",
+		Depreciation:     "n/a",
+	}
+
+	_, err := fromCashFlow(raw, formtype.Form10K, false)
+	require.Error(t, err)
+
+	var parseErr *ParseError
+	require.True(t, errors.As(err, &parseErr))
+	assert.Equal(t, "CashFlow", parseErr.Statement)
+	assert.Equal(t, "2019-12-31", parseErr.FiscalDateEnding)
+	assert.Equal(t, "2019-12-31", parseErr.Record)
+	assert.Equal(t, "netIncome", parseErr.Field)
+	assert.Equal(t, "9431e6", parseErr.Value)
+	assert.Contains(t, err.Error(), "Cannot parse CashFlow 2019-12-31 field 'netIncome' value '9431e6'")
+}
+
+func TestStatementFiscalDateEndingRequired(t *testing.T) {
+	for _, value := range []string{"None", "-", "", "null"} {
+		_, err := fromBalanceSheet(rawBalanceSheetItem{FiscalDateEnding: value}, formtype.Form10K, false)
+		require.Error(t, err, value)
+		var parseErr *ParseError
+		require.True(t, errors.As(err, &parseErr), value)
+		assert.Equal(t, "fiscalDateEnding", parseErr.Field)
+	}
+}
+
+func TestStatementParseErrorCollection(t *testing.T) {
+	raw := rawIncomeStatementItem{
+		FiscalDateEnding:                  "2019/12/31",
+		ReportedCurrency:                  "USD",
+		TotalRevenue:                      "77147000000",
+		TotalOperatingExpense:             "25945000000",
+		CostOfRevenue:                     "40659000000",
+		GrossProfit:                       "36488000000",
+		Ebit:                              "11511000000",
+		NetIncome:                         "9431000000",
+		ResearchAndDevelopment:            "5989000000",
+		EffectOfAccountingCharges:         "None",
+		IncomeBeforeTax:                   "10166000000",
+		MinorityInterest:                  "144000000",
+		SellingGeneralAdministrative:      "19956000000",
+		OtherNonOperatingIncome:           "968000000",
+		OperatingIncome:                   "10543000000",
+		OtherOperatingExpense:             "-614000000",
+		InterestExpense:                   "1344000000",
+		TaxProvision:                      "731000000",
+		InterestIncome:                    "349000000",
+		NetInterestIncome:                 "-995000000",
+		ExtraordinaryItems:                "-150000000",
+		NonRecurring:                      "None",
+		OtherItems:                        "None",
+		IncomeTaxExpense:                  "731000000",
+		TotalOtherIncomeExpense:           "529000000",
+		DiscontinuedOperations:            "-4000000",
+		NetIncomeFromContinuingOperations: "9435000000",
+		NetIncomeApplicableToCommonShares: "9431000000",
+		PreferredStockAndOtherAdjustments: "unknown",
+	}
+
+	_, err := fromIncomeStatement(raw, formtype.Form10K, true)
+	require.Error(t, err)
+
+	var parseErrs ParseErrors
+	require.True(t, errors.As(err, &parseErrs))
+	require.Equal(t, 2, len(parseErrs))
+	assert.Equal(t, "fiscalDateEnding", parseErrs[0].Field)
+	assert.Equal(t, "2019/12/31", parseErrs[0].Value)
+	assert.Equal(t, "preferredStockAndOtherAdjustments", parseErrs[1].Field)
+	assert.Equal(t, "unknown", parseErrs[1].Value)
+	for _, parseErr := range parseErrs {
+		assert.Equal(t, "IncomeStatement", parseErr.Statement)
+		assert.Equal(t, "2019/12/31", parseErr.FiscalDateEnding)
+	}
+}
+
+func TestClientParseErrorCollection(t *testing.T) {
+	httpClient := &fakeHTTPClient{
+		StatusCode: http.StatusOK,
+		Result: []byte(`{
+			"symbol": "IBM",
+			"annualReports": [{"fiscalDateEnding": "2019-12-31", "totalAssets": "lots", "goodwill": "some"}],
+			"quarterlyReports": []
+		}`),
+	}
+
+	_, err := BalanceSheets(context.TODO(), httpClient, "demo", "IBM")
+	require.Error(t, err)
+	var parseErr *ParseError
+	require.True(t, errors.As(err, &parseErr))
+	assert.Equal(t, "totalAssets", parseErr.Field)
+
+	client := NewClient(WithHTTPClient(httpClient), WithParseErrorCollection())
+	_, err = client.BalanceSheets(context.TODO(), "IBM")
+	require.Error(t, err)
+	var parseErrs ParseErrors
+	require.True(t, errors.As(err, &parseErrs))
+	values := map[string]string{}
+	for _, parseErr := range parseErrs {
+		values[parseErr.Field] = parseErr.Value
+	}
+	assert.Equal(t, "lots", values["totalAssets"])
+	assert.Equal(t, "some", values["goodwill"])
+	assert.Equal(t, "totalAssets", parseErrs[0].Field)
+}
+
+func TestClientParseErrorCollectionAllReports(t *testing.T) {
+	httpClient := &fakeHTTPClient{
+		StatusCode: http.StatusOK,
+		Result: []byte(`{
+			"symbol": "IBM",
+			"annualReports": [{"fiscalDateEnding": "2019-12-31", "totalAssets": "lots"}],
+			"quarterlyReports": [
+				{"fiscalDateEnding": "2020-03-31", "totalAssets": "1000"},
+				{"fiscalDateEnding": "2020-06-30", "goodwill": "some"}
+			]
+		}`),
+	}
+
+	client := NewClient(WithHTTPClient(httpClient), WithParseErrorCollection())
+	_, err := client.BalanceSheets(context.TODO(), "IBM")
+	require.Error(t, err)
+	var parseErrs ParseErrors
+	require.True(t, errors.As(err, &parseErrs))
+	require.Len(t, parseErrs, 2)
+	assert.Equal(t, "2019-12-31", parseErrs[0].FiscalDateEnding)
+	assert.Equal(t, "totalAssets", parseErrs[0].Field)
+	assert.Equal(t, "2020-06-30", parseErrs[1].FiscalDateEnding)
+	assert.Equal(t, "goodwill", parseErrs[1].Field)
+}
@@ -0,0 +1,182 @@
+package alphavantage
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// maxBulkQuoteSymbols how many symbols a single REALTIME_BULK_QUOTES request accepts
+const maxBulkQuoteSymbols = 100
+
+// Quote latest price and volume information of a symbol
+type Quote struct {
+	Symbol           string  `json:"symbol"`
+	Open             Decimal `json:"open"`
+	High             Decimal `json:"high"`
+	Low              Decimal `json:"low"`
+	Price            Decimal `json:"price"`
+	Volume           int64   `json:"volume"`
+	LatestTradingDay Date    `json:"latestTradingDay"`
+	PreviousClose    Decimal `json:"previousClose"`
+	Change           Decimal `json:"change"`
+	// ChangePercent is given in percent, e.g. -0.1305 for "-0.1305%"
+	ChangePercent Decimal `json:"changePercent"`
+}
+
+// BulkQuotes quotes of many symbols, symbols which cannot be quoted are reported in Failures
+type BulkQuotes struct {
+	// Quotes in the order of requested symbols
+	Quotes []Quote
+	// Failures errors by symbol
+	Failures map[string]error
+}
+
+// GlobalQuote makes API request and returns parsed response
+func (c *Client) GlobalQuote(ctx context.Context, symbol string) (Quote, error) {
+	response := rawGlobalQuoteResponse{}
+	if err := c.request(ctx, "GLOBAL_QUOTE", symbolParams(symbol), &response); err != nil {
+		return Quote{}, errors.Wrap(err, "GlobalQuote error")
+	}
+	if len(response.GlobalQuote) == 0 {
+		return Quote{}, errors.Wrapf(ErrInvalidSymbol, "GlobalQuote error: no quote for '%s'", symbol)
+	}
+	res, err := fromGlobalQuote(response.GlobalQuote, c.collectParseErrors)
+	if err != nil {
+		return Quote{}, errors.Wrap(err, "GlobalQuote parsing error")
+	}
+	return res, nil
+}
+
+// RealtimeBulkQuotes requests quotes of symbols in chunks of 100 symbols, this is a premium endpoint.
+// Symbols missing in the response or belonging to a failed chunk are reported in BulkQuotes.Failures;
+// the error is returned only when the whole call cannot succeed, e.g. invalid API key or non-premium plan.
+func (c *Client) RealtimeBulkQuotes(ctx context.Context, symbols []string) (BulkQuotes, error) {
+	res := BulkQuotes{Failures: map[string]error{}}
+	symbols = uniqueSymbols(symbols)
+	for start := 0; start < len(symbols); start += maxBulkQuoteSymbols {
+		end := start + maxBulkQuoteSymbols
+		if end > len(symbols) {
+			end = len(symbols)
+		}
+		chunk := symbols[start:end]
+
+		quotes, failures, err := c.realtimeBulkQuotes(ctx, chunk)
+		if err != nil {
+			if ctx.Err() != nil || errors.Is(err, ErrPremiumEndpoint) || errors.Is(err, ErrInvalidAPIKey) {
+				return res, errors.Wrap(err, "RealtimeBulkQuotes error")
+			}
+			for _, symbol := range chunk {
+				res.Failures[symbol] = errors.Wrap(err, "RealtimeBulkQuotes error")
+			}
+			continue
+		}
+		for _, symbol := range chunk {
+			if err, ok := failures[strings.ToUpper(symbol)]; ok {
+				res.Failures[symbol] = errors.Wrap(err, "RealtimeBulkQuotes parsing error")
+				continue
+			}
+			quote, ok := quotes[strings.ToUpper(symbol)]
+			if !ok {
+				res.Failures[symbol] = errors.Wrapf(ErrInvalidSymbol, "RealtimeBulkQuotes error: no quote for '%s'", symbol)
+				continue
+			}
+			res.Quotes = append(res.Quotes, quote)
+		}
+	}
+	return res, nil
+}
+
+// realtimeBulkQuotes makes a single API request, returns quotes and parsing errors by upper case symbol
+func (c *Client) realtimeBulkQuotes(ctx context.Context, symbols []string) (map[string]Quote, map[string]error, error) {
+	response := rawBulkQuotesResponse{}
+	params := url.Values{"symbol": {strings.Join(symbols, ",")}}
+	if err := c.request(ctx, "REALTIME_BULK_QUOTES", params, &response); err != nil {
+		return nil, nil, err
+	}
+	quotes := make(map[string]Quote, len(response.Data))
+	failures := map[string]error{}
+	for _, raw := range response.Data {
+		quote, err := fromBulkQuote(raw, c.collectParseErrors)
+		if err != nil {
+			failures[strings.ToUpper(quote.Symbol)] = err
+			continue
+		}
+		quotes[strings.ToUpper(quote.Symbol)] = quote
+	}
+	return quotes, failures, nil
+}
+
+// uniqueSymbols trims symbols and drops empty and duplicate ones
+func uniqueSymbols(symbols []string) []string {
+	seen := make(map[string]bool, len(symbols))
+	res := make([]string, 0, len(symbols))
+	for _, symbol := range symbols {
+		symbol = strings.TrimSpace(symbol)
+		key := strings.ToUpper(symbol)
+		if symbol == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		res = append(res, symbol)
+	}
+	return res
+}
+
+type rawGlobalQuoteResponse struct {
+	GlobalQuote map[string]string `json:"Global Quote"`
+}
+
+type rawBulkQuotesResponse struct {
+	Data []map[string]json.RawMessage `json:"data"`
+}
+
+func fromGlobalQuote(raw map[string]string, collectAll bool) (Quote, error) {
+	fields := make(map[string]string, len(raw))
+	for k, v := range raw {
+		fields[stripKeyIndex(k)] = v
+	}
+	p := newFieldParser("Quote", fields["symbol"], collectAll)
+	res := Quote{
+		Symbol:           fields["symbol"],
+		Open:             p.decimal("open", fields["open"]),
+		High:             p.decimal("high", fields["high"]),
+		Low:              p.decimal("low", fields["low"]),
+		Price:            p.decimal("price", fields["price"]),
+		Volume:           p.int64("volume", fields["volume"]),
+		LatestTradingDay: p.date("latest trading day", fields["latest trading day"]),
+		PreviousClose:    p.decimal("previous close", fields["previous close"]),
+		Change:           p.decimal("change", fields["change"]),
+		ChangePercent:    p.decimal("change percent", strings.TrimSuffix(fields["change percent"], "%")),
+	}
+	return res, p.err()
+}
+
+func fromBulkQuote(raw map[string]json.RawMessage, collectAll bool) (Quote, error) {
+	// numbers are quoted, but be lenient about unquoted ones
+	fields := make(map[string]string, len(raw))
+	for k, v := range raw {
+		fields[k] = strings.Trim(string(v), "\"")
+	}
+	p := newFieldParser("Quote", fields["symbol"], collectAll)
+	timestamp := fields["timestamp"]
+	if len(timestamp) > len(dateLayout) {
+		timestamp = timestamp[:len(dateLayout)]
+	}
+	res := Quote{
+		Symbol:           fields["symbol"],
+		Open:             p.decimal("open", fields["open"]),
+		High:             p.decimal("high", fields["high"]),
+		Low:              p.decimal("low", fields["low"]),
+		Price:            p.decimal("close", fields["close"]),
+		Volume:           p.int64("volume", fields["volume"]),
+		LatestTradingDay: p.date("timestamp", timestamp),
+		PreviousClose:    p.decimal("previous_close", fields["previous_close"]),
+		Change:           p.decimal("change", fields["change"]),
+		ChangePercent:    p.decimal("change_percent", strings.TrimSuffix(fields["change_percent"], "%")),
+	}
+	return res, p.err()
+}
@@ -0,0 +1,139 @@
+package alphavantage
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGlobalQuote(t *testing.T) {
+	httpClient := &fakeHTTPClient{StatusCode: http.StatusOK, Result: []byte(`
+	{
+		"Global Quote": {
+			"01. symbol": "IBM",
+			"02. open": "124.2000",
+			"03. high": "125.5600",
+			"04. low": "123.9100",
+			"05. price": "125.2700",
+			"06. volume": "2963753",
+			"07. latest trading day": "2020-08-14",
+			"08. previous close": "125.0300",
+			"09. change": "0.2400",
+			"10. change percent": "0.1920%"
+		}
+	}`)}
+	client := NewClient(WithHTTPClient(httpClient), WithAPIKey("demo"))
+
+	res, err := client.GlobalQuote(context.TODO(), "IBM")
+	require.NoError(t, err)
+	assert.Equal(t, "https://www.alphavantage.co/query?function=GLOBAL_QUOTE&symbol=IBM&apikey=demo", httpClient.Request.URL.String())
+	assert.Equal(t, "IBM", res.Symbol)
+	assert.Equal(t, "125.2700", res.Price.String())
+	assert.Equal(t, "124.2000", res.Open.String())
+	assert.Equal(t, int64(2963753), res.Volume)
+	assert.Equal(t, "2020-08-14", res.LatestTradingDay.String())
+	assert.Equal(t, "125.0300", res.PreviousClose.String())
+	assert.Equal(t, "0.2400", res.Change.String())
+	assert.Equal(t, "0.1920", res.ChangePercent.String())
+}
+
+func TestGlobalQuoteUnknownSymbol(t *testing.T) {
+	httpClient := &fakeHTTPClient{StatusCode: http.StatusOK, Result: []byte(`{"Global Quote": {}}`)}
+	client := NewClient(WithHTTPClient(httpClient))
+
+	_, err := client.GlobalQuote(context.TODO(), "NOPE")
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrInvalidSymbol))
+}
+
+func testBulkQuote(symbol string, close string) string {
+	return fmt.Sprintf(`{
+		"symbol": "%s",
+		"timestamp": "2024-03-22 16:00:00.000",
+		"open": "429.7000",
+		"high": "429.8600",
+		"low": "426.0700",
+		"close": "%s",
+		"volume": "17636489",
+		"previous_close": "429.3700",
+		"change": "-0.6300",
+		"change_percent": "-0.14673",
+		"extended_hours_quote": "428.5700"
+	}`, symbol, close)
+}
+
+func TestRealtimeBulkQuotes(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested := strings.Split(r.URL.Query().Get("symbol"), ",")
+		requests = append(requests, r.URL.Query().Get("symbol"))
+		var data []string
+		for _, symbol := range requested {
+			switch symbol {
+			case "BAD":
+			case "BROKEN":
+				data = append(data, testBulkQuote(symbol, "n/a"))
+			default:
+				data = append(data, testBulkQuote(strings.ToUpper(symbol), "428.7400"))
+			}
+		}
+		_, _ = w.Write([]byte(`{"endpoint": "Realtime Bulk Quotes", "message": "", "data": [` + strings.Join(data, ",") + `]}`))
+	}))
+	defer server.Close()
+
+	symbols := []string{"msft", "BAD", "BROKEN", "MSFT"}
+	for i := 0; i < 150; i++ {
+		symbols = append(symbols, fmt.Sprintf("S%03d", i))
+	}
+	client := NewClient(WithBaseURL(server.URL), WithHTTPClient(server.Client()))
+
+	res, err := client.RealtimeBulkQuotes(context.TODO(), symbols)
+	require.NoError(t, err)
+	require.Len(t, requests, 2)
+	assert.Len(t, strings.Split(requests[0], ","), 100)
+	assert.Len(t, strings.Split(requests[1], ","), 53)
+
+	require.Len(t, res.Quotes, 151)
+	assert.Equal(t, "MSFT", res.Quotes[0].Symbol)
+	assert.Equal(t, "428.7400", res.Quotes[0].Price.String())
+	assert.Equal(t, "2024-03-22", res.Quotes[0].LatestTradingDay.String())
+	assert.Equal(t, "-0.14673", res.Quotes[0].ChangePercent.String())
+	assert.Equal(t, "S000", res.Quotes[1].Symbol)
+
+	require.Len(t, res.Failures, 2)
+	assert.True(t, errors.Is(res.Failures["BAD"], ErrInvalidSymbol))
+	var parseErr *ParseError
+	assert.True(t, errors.As(res.Failures["BROKEN"], &parseErr))
+	assert.Equal(t, "close", parseErr.Field)
+	assert.Equal(t, "BROKEN", parseErr.Record)
+	assert.Empty(t, parseErr.FiscalDateEnding)
+}
+
+func TestRealtimeBulkQuotesPremium(t *testing.T) {
+	httpClient := &fakeHTTPClient{StatusCode: http.StatusOK, Result: []byte(`{"Information": "Thank you for using Alpha Vantage! This is a premium endpoint."}`)}
+	client := NewClient(WithHTTPClient(httpClient))
+
+	_, err := client.RealtimeBulkQuotes(context.TODO(), []string{"IBM", "MSFT"})
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, ErrPremiumEndpoint))
+	assert.Equal(t, "IBM,MSFT", httpClient.Request.URL.Query().Get("symbol"))
+}
+
+func TestRealtimeBulkQuotesChunkFailure(t *testing.T) {
+	httpClient := &fakeHTTPClient{StatusCode: http.StatusBadGateway}
+	client := NewClient(WithHTTPClient(httpClient))
+
+	res, err := client.RealtimeBulkQuotes(context.TODO(), []string{"IBM", "MSFT"})
+	require.NoError(t, err)
+	assert.Empty(t, res.Quotes)
+	require.Len(t, res.Failures, 2)
+	var httpErr *HTTPError
+	assert.True(t, errors.As(res.Failures["MSFT"], &httpErr))
+}
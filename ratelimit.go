@@ -0,0 +1,156 @@
+package alphavantage
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// Limiter throttles outgoing requests
+type Limiter interface {
+	// Wait blocks until a request is allowed or ctx is done
+	Wait(ctx context.Context) error
+}
+
+// Quota describes alphavantage plan limits; zero means unlimited
+type Quota struct {
+	PerMinute int
+	PerDay    int
+}
+
+var (
+	// FreeQuota free tier limits
+	FreeQuota = Quota{PerMinute: 5, PerDay: 25}
+	// Premium75Quota premium plan with 75 requests per minute
+	Premium75Quota = Quota{PerMinute: 75}
+	// Premium150Quota premium plan with 150 requests per minute
+	Premium150Quota = Quota{PerMinute: 150}
+	// Premium300Quota premium plan with 300 requests per minute
+	Premium300Quota = Quota{PerMinute: 300}
+	// Premium600Quota premium plan with 600 requests per minute
+	Premium600Quota = Quota{PerMinute: 600}
+	// Premium1200Quota premium plan with 1200 requests per minute
+	Premium1200Quota = Quota{PerMinute: 1200}
+)
+
+// Budget remaining number of requests; -1 means unlimited
+type Budget struct {
+	Minute int
+	Day    int
+}
+
+// RateLimiter token bucket limiter with per-minute and per-day windows, safe for concurrent use
+type RateLimiter struct {
+	mu     sync.Mutex
+	now    func() time.Time
+	minute *tokenBucket
+	day    *tokenBucket
+}
+
+// NewRateLimiter creates RateLimiter enforcing quota
+func NewRateLimiter(quota Quota) *RateLimiter {
+	l := &RateLimiter{now: time.Now}
+	start := l.now()
+	if quota.PerMinute > 0 {
+		l.minute = newTokenBucket(quota.PerMinute, time.Minute, start)
+	}
+	if quota.PerDay > 0 {
+		l.day = newTokenBucket(quota.PerDay, 24*time.Hour, start)
+	}
+	return l
+}
+
+// Wait blocks until both windows have a token available or ctx is done
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	for {
+		delay := l.reserve()
+		if delay == 0 {
+			return nil
+		}
+
+		if err := sleepContext(ctx, delay); err != nil {
+			return err
+		}
+	}
+}
+
+// Remaining returns requests available right now
+func (l *RateLimiter) Remaining() Budget {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	return Budget{
+		Minute: l.minute.remaining(now),
+		Day:    l.day.remaining(now),
+	}
+}
+
+// reserve takes a token from both windows and returns 0, or returns how long to wait for one
+func (l *RateLimiter) reserve() time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	delay := l.minute.delay(now)
+	if d := l.day.delay(now); d > delay {
+		delay = d
+	}
+	if delay > 0 {
+		return delay
+	}
+	l.minute.take()
+	l.day.take()
+	return 0
+}
+
+type tokenBucket struct {
+	capacity float64
+	tokens   float64
+	// refill rate in tokens per nanosecond
+	rate float64
+	last time.Time
+}
+
+func newTokenBucket(capacity int, window time.Duration, now time.Time) *tokenBucket {
+	return &tokenBucket{
+		capacity: float64(capacity),
+		tokens:   float64(capacity),
+		rate:     float64(capacity) / float64(window),
+		last:     now,
+	}
+}
+
+func (b *tokenBucket) refill(now time.Time) {
+	if elapsed := now.Sub(b.last); elapsed > 0 {
+		b.tokens = math.Min(b.capacity, b.tokens+float64(elapsed)*b.rate)
+		b.last = now
+	}
+}
+
+// delay returns time until the next token is available; nil bucket is unlimited
+func (b *tokenBucket) delay(now time.Time) time.Duration {
+	if b == nil {
+		return 0
+	}
+	b.refill(now)
+	if b.tokens >= 1 {
+		return 0
+	}
+	return time.Duration(math.Ceil((1 - b.tokens) / b.rate))
+}
+
+func (b *tokenBucket) take() {
+	if b != nil {
+		b.tokens--
+	}
+}
+
+func (b *tokenBucket) remaining(now time.Time) int {
+	if b == nil {
+		return -1
+	}
+	b.refill(now)
+	return int(b.tokens)
+}
@@ -0,0 +1,122 @@
+package alphavantage
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Add(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func newTestRateLimiter(quota Quota, clock *fakeClock) *RateLimiter {
+	l := NewRateLimiter(quota)
+	l.now = clock.Now
+	if l.minute != nil {
+		l.minute.last = clock.Now()
+	}
+	if l.day != nil {
+		l.day.last = clock.Now()
+	}
+	return l
+}
+
+func TestRateLimiterPerMinute(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2020, 9, 1, 0, 0, 0, 0, time.UTC)}
+	l := newTestRateLimiter(FreeQuota, clock)
+	ctx := context.TODO()
+
+	for i := 0; i < 5; i++ {
+		require.Equal(t, time.Duration(0), l.reserve())
+	}
+	assert.Equal(t, Budget{Minute: 0, Day: 20}, l.Remaining())
+	assert.Equal(t, 12*time.Second, l.reserve())
+
+	clock.Add(12 * time.Second)
+	require.NoError(t, l.Wait(ctx))
+	assert.Equal(t, Budget{Minute: 0, Day: 19}, l.Remaining())
+}
+
+func TestRateLimiterPerDay(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2020, 9, 1, 0, 0, 0, 0, time.UTC)}
+	l := newTestRateLimiter(Quota{PerMinute: 100, PerDay: 2}, clock)
+
+	require.Equal(t, time.Duration(0), l.reserve())
+	require.Equal(t, time.Duration(0), l.reserve())
+	assert.Equal(t, 12*time.Hour, l.reserve())
+
+	clock.Add(time.Hour)
+	assert.Equal(t, 11*time.Hour, l.reserve())
+}
+
+func TestRateLimiterUnlimited(t *testing.T) {
+	l := NewRateLimiter(Quota{})
+	for i := 0; i < 1000; i++ {
+		require.NoError(t, l.Wait(context.TODO()))
+	}
+	assert.Equal(t, Budget{Minute: -1, Day: -1}, l.Remaining())
+}
+
+func TestRateLimiterContextCancel(t *testing.T) {
+	l := NewRateLimiter(Quota{PerMinute: 1})
+	require.NoError(t, l.Wait(context.TODO()))
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Millisecond)
+	defer cancel()
+	assert.Equal(t, context.DeadlineExceeded, l.Wait(ctx))
+}
+
+func TestRateLimiterConcurrent(t *testing.T) {
+	l := NewRateLimiter(Quota{PerMinute: 50, PerDay: 100})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, l.Wait(context.TODO()))
+		}()
+	}
+	wg.Wait()
+
+	budget := l.Remaining()
+	assert.Equal(t, 0, budget.Minute)
+	assert.Equal(t, 50, budget.Day)
+}
+
+func TestClientWithLimiter(t *testing.T) {
+	httpClient := &fakeHTTPClient{
+		StatusCode: http.StatusOK,
+		Result:     []byte(`{"Symbol": "IBM", "LatestQuarter": "2020-06-30"}`),
+	}
+	l := NewRateLimiter(Quota{PerMinute: 1})
+	client := NewClient(WithHTTPClient(httpClient), WithLimiter(l))
+
+	_, err := client.CompanyProfile(context.TODO(), "IBM")
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Millisecond)
+	defer cancel()
+	_, err = client.CompanyProfile(ctx, "IBM")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Rate limiter error")
+}
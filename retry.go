@@ -0,0 +1,113 @@
+package alphavantage
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// RetryPolicy configures retries of transient failures: network errors, HTTP 5xx and 429, and rate limit soft errors.
+// Invalid symbol, invalid API key and premium endpoint errors are never retried.
+type RetryPolicy struct {
+	// MaxAttempts total number of attempts including the first one; 1 or less disables retries
+	MaxAttempts int
+	// BaseDelay delay before the first retry, doubled on every next attempt
+	BaseDelay time.Duration
+	// MaxDelay caps the exponential backoff
+	MaxDelay time.Duration
+	// Jitter fraction of the delay randomized, from 0 (none) to 1 (full jitter)
+	Jitter float64
+	// RateLimitDelay minimum delay after ErrRateLimited
+	RateLimitDelay time.Duration
+	// OnRetry is called before sleeping for every retry
+	OnRetry func(RetryAttempt)
+
+	random func() float64
+}
+
+// RetryAttempt describes a failed attempt which is going to be retried
+type RetryAttempt struct {
+	// Attempt number of the failed attempt starting from 1
+	Attempt int
+	// Err error of the failed attempt
+	Err error
+	// Delay before the next attempt
+	Delay time.Duration
+}
+
+// DefaultRetryPolicy returns reasonable retry settings for the free tier
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    4,
+		BaseDelay:      500 * time.Millisecond,
+		MaxDelay:       30 * time.Second,
+		Jitter:         0.2,
+		RateLimitDelay: 15 * time.Second,
+	}
+}
+
+// WithRetryPolicy enables retries of transient failures
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(c *Client) {
+		c.retry = &policy
+	}
+}
+
+// retryDelay returns delay before the next attempt, false if err should not be retried
+func (p *RetryPolicy) retryDelay(attempt int, err error) (time.Duration, bool) {
+	if p == nil || attempt >= p.MaxAttempts || !isRetryable(err) {
+		return 0, false
+	}
+
+	delay := p.backoff(attempt)
+	if errors.Is(err, ErrRateLimited) && delay < p.RateLimitDelay {
+		delay = p.RateLimitDelay
+	}
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) && delay < httpErr.RetryAfter {
+		delay = httpErr.RetryAfter
+	}
+	return delay, true
+}
+
+func (p *RetryPolicy) backoff(attempt int) time.Duration {
+	delay := float64(p.BaseDelay) * math.Pow(2, float64(attempt-1))
+	if p.MaxDelay > 0 && delay > float64(p.MaxDelay) {
+		delay = float64(p.MaxDelay)
+	}
+	if p.Jitter > 0 {
+		random := p.random
+		if random == nil {
+			random = rand.Float64
+		}
+		delay -= delay * math.Min(p.Jitter, 1) * random()
+	}
+	return time.Duration(delay)
+}
+
+func isRetryable(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.Kind == ErrRateLimited
+	}
+
+	var httpErr *HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.StatusCode >= http.StatusInternalServerError || httpErr.StatusCode == http.StatusTooManyRequests
+	}
+	return true
+}
+
+// withinDeadline reports whether ctx deadline allows to wait for delay
+func withinDeadline(ctx context.Context, delay time.Duration) bool {
+	deadline, ok := ctx.Deadline()
+	return !ok || time.Now().Add(delay).Before(deadline)
+}
@@ -0,0 +1,216 @@
+package alphavantage
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeResponse struct {
+	StatusCode int
+	Header     http.Header
+	Result     []byte
+	Err        error
+}
+
+// sequenceHTTPClient replies with Responses one by one, repeating the last one
+type sequenceHTTPClient struct {
+	Responses []fakeResponse
+	Calls     int
+}
+
+func (c *sequenceHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	index := c.Calls
+	if index >= len(c.Responses) {
+		index = len(c.Responses) - 1
+	}
+	c.Calls++
+
+	response := c.Responses[index]
+	if response.Err != nil {
+		return nil, response.Err
+	}
+	return &http.Response{
+		StatusCode: response.StatusCode,
+		Header:     response.Header,
+		Body:       ioutil.NopCloser(bytes.NewReader(response.Result)),
+	}, nil
+}
+
+func testRetryPolicy(attempts *[]RetryAttempt) RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: 3,
+		BaseDelay:   time.Millisecond,
+		MaxDelay:    5 * time.Millisecond,
+		OnRetry: func(attempt RetryAttempt) {
+			*attempts = append(*attempts, attempt)
+		},
+	}
+}
+
+func TestRetryTransientFailures(t *testing.T) {
+	httpClient := &sequenceHTTPClient{
+		Responses: []fakeResponse{
+			{Err: errors.New("connection reset by peer")},
+			{StatusCode: http.StatusBadGateway},
+			{StatusCode: http.StatusOK, Result: []byte(`{"Symbol": "IBM", "LatestQuarter": "2020-06-30"}`)},
+		},
+	}
+	var attempts []RetryAttempt
+	client := NewClient(WithHTTPClient(httpClient), WithRetryPolicy(testRetryPolicy(&attempts)))
+
+	data, err := client.CompanyProfile(context.TODO(), "IBM")
+	require.NoError(t, err)
+	assert.Equal(t, "IBM", data.Symbol)
+	assert.Equal(t, 3, httpClient.Calls)
+	require.Equal(t, 2, len(attempts))
+	assert.Equal(t, 1, attempts[0].Attempt)
+	assert.Equal(t, time.Millisecond, attempts[0].Delay)
+	assert.Equal(t, 2, attempts[1].Attempt)
+	assert.Equal(t, 2*time.Millisecond, attempts[1].Delay)
+}
+
+func TestRetryGivesUp(t *testing.T) {
+	httpClient := &sequenceHTTPClient{
+		Responses: []fakeResponse{{StatusCode: http.StatusServiceUnavailable}},
+	}
+	var attempts []RetryAttempt
+	client := NewClient(WithHTTPClient(httpClient), WithRetryPolicy(testRetryPolicy(&attempts)))
+
+	_, err := client.CompanyProfile(context.TODO(), "IBM")
+	require.Error(t, err)
+	var httpErr *HTTPError
+	require.True(t, errors.As(err, &httpErr))
+	assert.Equal(t, http.StatusServiceUnavailable, httpErr.StatusCode)
+	assert.Equal(t, 3, httpClient.Calls)
+	assert.Equal(t, 2, len(attempts))
+}
+
+func TestRetryNeverRetriesPermanentErrors(t *testing.T) {
+	testCases := map[string]fakeResponse{
+		"invalid symbol":   {StatusCode: http.StatusOK, Result: []byte(`{"Error Message": "Invalid API call. Please retry or visit the documentation."}`)},
+		"invalid key":      {StatusCode: http.StatusOK, Result: []byte(`{"Error Message": "the parameter apikey is invalid or missing."}`)},
+		"premium endpoint": {StatusCode: http.StatusOK, Result: []byte(`{"Information": "Thank you for using Alpha Vantage! This is a premium endpoint."}`)},
+		"bad request":      {StatusCode: http.StatusBadRequest},
+	}
+
+	for name, response := range testCases {
+		httpClient := &sequenceHTTPClient{Responses: []fakeResponse{response}}
+		var attempts []RetryAttempt
+		client := NewClient(WithHTTPClient(httpClient), WithRetryPolicy(testRetryPolicy(&attempts)))
+
+		_, err := client.CompanyProfile(context.TODO(), "IBM")
+		require.Error(t, err, name)
+		assert.Equal(t, 1, httpClient.Calls, name)
+		assert.Empty(t, attempts, name)
+	}
+}
+
+func TestRetryRateLimited(t *testing.T) {
+	httpClient := &sequenceHTTPClient{
+		Responses: []fakeResponse{
+			{StatusCode: http.StatusOK, Result: []byte(`{"Note": "Our standard API call frequency is 5 calls per minute."}`)},
+			{StatusCode: http.StatusOK, Result: []byte(`{"Symbol": "IBM", "LatestQuarter": "2020-06-30"}`)},
+		},
+	}
+	var attempts []RetryAttempt
+	policy := testRetryPolicy(&attempts)
+	policy.RateLimitDelay = 10 * time.Millisecond
+	client := NewClient(WithHTTPClient(httpClient), WithRetryPolicy(policy))
+
+	_, err := client.CompanyProfile(context.TODO(), "IBM")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(attempts))
+	assert.True(t, errors.Is(attempts[0].Err, ErrRateLimited))
+	assert.Equal(t, 10*time.Millisecond, attempts[0].Delay)
+}
+
+func TestRetryHonoursRetryAfter(t *testing.T) {
+	httpClient := &sequenceHTTPClient{
+		Responses: []fakeResponse{
+			{StatusCode: http.StatusTooManyRequests, Header: http.Header{"Retry-After": {"1"}}},
+			{StatusCode: http.StatusOK, Result: []byte(`{"Symbol": "IBM", "LatestQuarter": "2020-06-30"}`)},
+		},
+	}
+	var attempts []RetryAttempt
+	client := NewClient(WithHTTPClient(httpClient), WithRetryPolicy(testRetryPolicy(&attempts)))
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 100*time.Millisecond)
+	defer cancel()
+	_, err := client.CompanyProfile(ctx, "IBM")
+	require.Error(t, err)
+	assert.Equal(t, 1, httpClient.Calls, "Retry-After exceeds context deadline")
+	assert.Empty(t, attempts)
+
+	httpClient.Calls = 0
+	_, err = client.CompanyProfile(context.TODO(), "IBM")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(attempts))
+	assert.Equal(t, time.Second, attempts[0].Delay)
+}
+
+func TestRetryCancelledDuringBackoff(t *testing.T) {
+	httpClient := &sequenceHTTPClient{
+		Responses: []fakeResponse{{StatusCode: http.StatusBadGateway}},
+	}
+	ctx, cancel := context.WithCancel(context.TODO())
+	policy := RetryPolicy{
+		MaxAttempts: 3,
+		BaseDelay:   time.Hour,
+		MaxDelay:    time.Hour,
+		OnRetry: func(attempt RetryAttempt) {
+			cancel()
+		},
+	}
+	client := NewClient(WithHTTPClient(httpClient), WithRetryPolicy(policy))
+
+	_, err := client.CompanyProfile(ctx, "IBM")
+	require.Error(t, err)
+	assert.True(t, errors.Is(err, context.Canceled))
+	assert.Contains(t, err.Error(), "502")
+	assert.Equal(t, 1, httpClient.Calls)
+}
+
+func TestRetryBackoffJitter(t *testing.T) {
+	policy := RetryPolicy{
+		MaxAttempts: 10,
+		BaseDelay:   time.Second,
+		MaxDelay:    5 * time.Second,
+		Jitter:      0.5,
+		random:      func() float64 { return 1 },
+	}
+
+	testCases := map[int]time.Duration{
+		1: 500 * time.Millisecond,
+		2: time.Second,
+		3: 2 * time.Second,
+		4: 2500 * time.Millisecond,
+		9: 2500 * time.Millisecond,
+	}
+	for attempt, expected := range testCases {
+		assert.Equal(t, expected, policy.backoff(attempt), attempt)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2020, 9, 1, 0, 0, 0, 0, time.UTC)
+	testCases := map[string]time.Duration{
+		"":                              0,
+		"120":                           2 * time.Minute,
+		"-1":                            0,
+		"soon":                          0,
+		"Tue, 01 Sep 2020 00:00:30 GMT": 30 * time.Second,
+		"Mon, 31 Aug 2020 00:00:30 GMT": 0,
+	}
+
+	for input, expected := range testCases {
+		assert.Equal(t, expected, parseRetryAfter(input, now), input)
+	}
+}
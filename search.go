@@ -0,0 +1,105 @@
+package alphavantage
+
+import (
+	"context"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// SymbolMatch single SYMBOL_SEARCH result
+type SymbolMatch struct {
+	Symbol string `json:"symbol"`
+	Name   string `json:"name"`
+	// Type is the asset type, e.g. "Equity" or "ETF"
+	Type   string `json:"type"`
+	Region string `json:"region"`
+	// MarketOpen and MarketClose are local trading hours, e.g. "09:30"
+	MarketOpen  string `json:"marketOpen"`
+	MarketClose string `json:"marketClose"`
+	// TimeZone is UTC offset, e.g. "UTC-04"
+	TimeZone string `json:"timezone"`
+	Currency string `json:"currency"`
+	// MatchScore is between 0 and 1, 1 being the exact match
+	MatchScore float64 `json:"matchScore"`
+}
+
+// SymbolMatches search results sorted by MatchScore, best match first
+type SymbolMatches []SymbolMatch
+
+// SymbolSearch makes API request and returns parsed response
+func (c *Client) SymbolSearch(ctx context.Context, keywords string) (SymbolMatches, error) {
+	response := rawSymbolSearchResponse{}
+	if err := c.request(ctx, "SYMBOL_SEARCH", url.Values{"keywords": {keywords}}, &response); err != nil {
+		return nil, errors.Wrap(err, "SymbolSearch error")
+	}
+	res := make(SymbolMatches, 0, len(response.BestMatches))
+	var parseErrs ParseErrors
+	for _, raw := range response.BestMatches {
+		m, err := fromSymbolMatch(raw, c.collectParseErrors)
+		if err != nil {
+			if parseErrs.collect(err) {
+				continue
+			}
+			return nil, errors.Wrap(err, "SymbolSearch parsing error")
+		}
+		res = append(res, m)
+	}
+	if len(parseErrs) > 0 {
+		return nil, errors.Wrap(parseErrs, "SymbolSearch parsing error")
+	}
+	sort.SliceStable(res, func(i, j int) bool {
+		return res[i].MatchScore > res[j].MatchScore
+	})
+	return res, nil
+}
+
+// FilterRegion returns matches of region, e.g. "United States"; comparison is case-insensitive
+func (m SymbolMatches) FilterRegion(region string) SymbolMatches {
+	return m.filter(func(match SymbolMatch) bool {
+		return strings.EqualFold(match.Region, region)
+	})
+}
+
+// FilterType returns matches of asset type, e.g. "Equity"; comparison is case-insensitive
+func (m SymbolMatches) FilterType(assetType string) SymbolMatches {
+	return m.filter(func(match SymbolMatch) bool {
+		return strings.EqualFold(match.Type, assetType)
+	})
+}
+
+func (m SymbolMatches) filter(keep func(SymbolMatch) bool) SymbolMatches {
+	res := make(SymbolMatches, 0, len(m))
+	for _, match := range m {
+		if keep(match) {
+			res = append(res, match)
+		}
+	}
+	return res
+}
+
+type rawSymbolSearchResponse struct {
+	BestMatches []map[string]string `json:"bestMatches"`
+}
+
+func fromSymbolMatch(raw map[string]string, collectAll bool) (SymbolMatch, error) {
+	fields := make(map[string]string, len(raw))
+	for k, v := range raw {
+		fields[stripKeyIndex(k)] = v
+	}
+	p := newFieldParser("SymbolMatch", fields["symbol"], collectAll)
+	res := SymbolMatch{
+		Symbol:      fields["symbol"],
+		Name:        fields["name"],
+		Type:        fields["type"],
+		Region:      fields["region"],
+		MarketOpen:  fields["marketOpen"],
+		MarketClose: fields["marketClose"],
+		TimeZone:    fields["timezone"],
+		Currency:    fields["currency"],
+		MatchScore:  p.float64("matchScore", fields["matchScore"]),
+	}
+	return res, p.err()
+}
@@ -0,0 +1,92 @@
+package alphavantage
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testSymbolSearch = []byte(`
+{
+	"bestMatches": [
+		{
+			"1. symbol": "TSCO.LON",
+			"2. name": "Tesco PLC",
+			"3. type": "Equity",
+			"4. region": "United Kingdom",
+			"5. marketOpen": "08:00",
+			"6. marketClose": "16:30",
+			"7. timezone": "UTC+01",
+			"8. currency": "GBX",
+			"9. matchScore": "0.7273"
+		},
+		{
+			"1. symbol": "TSCDY",
+			"2. name": "Tesco plc",
+			"3. type": "Equity",
+			"4. region": "United States",
+			"5. marketOpen": "09:30",
+			"6. marketClose": "16:00",
+			"7. timezone": "UTC-04",
+			"8. currency": "USD",
+			"9. matchScore": "0.7143"
+		},
+		{
+			"1. symbol": "TESCO",
+			"2. name": "Tesco Index Fund",
+			"3. type": "Mutual Fund",
+			"4. region": "United States",
+			"5. marketOpen": "09:30",
+			"6. marketClose": "16:00",
+			"7. timezone": "UTC-04",
+			"8. currency": "USD",
+			"9. matchScore": "1.0000"
+		}
+	]
+}`)
+
+func TestSymbolSearch(t *testing.T) {
+	httpClient := &fakeHTTPClient{StatusCode: http.StatusOK, Result: testSymbolSearch}
+	client := NewClient(WithHTTPClient(httpClient), WithAPIKey("demo"))
+
+	res, err := client.SymbolSearch(context.TODO(), "tesco plc")
+	require.NoError(t, err)
+	assert.Equal(t, "https://www.alphavantage.co/query?function=SYMBOL_SEARCH&keywords=tesco+plc&apikey=demo", httpClient.Request.URL.String())
+
+	require.Len(t, res, 3)
+	assert.Equal(t, "TESCO", res[0].Symbol)
+	assert.Equal(t, 1.0, res[0].MatchScore)
+	assert.Equal(t, SymbolMatch{
+		Symbol:      "TSCO.LON",
+		Name:        "Tesco PLC",
+		Type:        "Equity",
+		Region:      "United Kingdom",
+		MarketOpen:  "08:00",
+		MarketClose: "16:30",
+		TimeZone:    "UTC+01",
+		Currency:    "GBX",
+		MatchScore:  0.7273,
+	}, res[1])
+	assert.Equal(t, "TSCDY", res[2].Symbol)
+
+	us := res.FilterRegion("united states")
+	require.Len(t, us, 2)
+	assert.Equal(t, "TESCO", us[0].Symbol)
+
+	equities := us.FilterType("Equity")
+	require.Len(t, equities, 1)
+	assert.Equal(t, "TSCDY", equities[0].Symbol)
+}
+
+func TestSymbolSearchEmpty(t *testing.T) {
+	httpClient := &fakeHTTPClient{StatusCode: http.StatusOK, Result: []byte(`{"bestMatches": []}`)}
+	client := NewClient(WithHTTPClient(httpClient))
+
+	res, err := client.SymbolSearch(context.TODO(), "zzzz")
+	require.NoError(t, err)
+	assert.Empty(t, res)
+	assert.Empty(t, res.FilterRegion("United States"))
+}
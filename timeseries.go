@@ -0,0 +1,360 @@
+package alphavantage
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// OutputSize controls how many data points time series requests return
+type OutputSize string
+
+const (
+	// OutputSizeCompact returns the latest 100 data points
+	OutputSizeCompact OutputSize = "compact"
+	// OutputSizeFull returns the full-length history
+	OutputSizeFull OutputSize = "full"
+)
+
+// DataType response format of time series requests
+type DataType string
+
+const (
+	// DataTypeJSON nested JSON maps, the default
+	DataTypeJSON DataType = "json"
+	// DataTypeCSV CSV table, smaller and faster to parse, but without "Meta Data"
+	DataTypeCSV DataType = "csv"
+)
+
+// TimeSeriesOptions optional parameters of time series requests, the zero value uses alphavantage defaults
+type TimeSeriesOptions struct {
+	// OutputSize is supported by daily series only
+	OutputSize OutputSize
+	DataType   DataType
+}
+
+func (o TimeSeriesOptions) params(symbol string) url.Values {
+	params := symbolParams(symbol)
+	if o.OutputSize != "" {
+		params.Set("outputsize", string(o.OutputSize))
+	}
+	if o.DataType != "" {
+		params.Set("datatype", string(o.DataType))
+	}
+	return params
+}
+
+// isCSV reports whether params request CSV response
+func isCSV(params url.Values) bool {
+	return params.Get("datatype") == string(DataTypeCSV)
+}
+
+// TimeSeriesMetadata parsed "Meta Data" block of time series responses
+type TimeSeriesMetadata struct {
+	Information   string `json:"information"`
+	Symbol        string `json:"symbol"`
+	LastRefreshed string `json:"lastRefreshed"`
+	Interval      string `json:"interval,omitempty"`
+	OutputSize    string `json:"outputSize,omitempty"`
+	TimeZone      string `json:"timeZone"`
+}
+
+// Bar open, high, low, close prices and volume of a single period
+type Bar struct {
+	Date   Date    `json:"date"`
+	Open   Decimal `json:"open"`
+	High   Decimal `json:"high"`
+	Low    Decimal `json:"low"`
+	Close  Decimal `json:"close"`
+	Volume int64   `json:"volume"`
+}
+
+// AdjustedBar Bar with split and dividend adjustments
+type AdjustedBar struct {
+	Bar
+	AdjustedClose  Decimal `json:"adjustedClose"`
+	DividendAmount Decimal `json:"dividendAmount"`
+	// SplitCoefficient is reported by daily series only
+	SplitCoefficient NullDecimal `json:"splitCoefficient"`
+}
+
+// TimeSeries bars in chronological order
+type TimeSeries struct {
+	Metadata TimeSeriesMetadata `json:"metadata"`
+	Bars     []Bar              `json:"bars"`
+}
+
+// AdjustedTimeSeries adjusted bars in chronological order
+type AdjustedTimeSeries struct {
+	Metadata TimeSeriesMetadata `json:"metadata"`
+	Bars     []AdjustedBar      `json:"bars"`
+}
+
+// TimeSeriesDaily makes API request and returns parsed response
+func (c *Client) TimeSeriesDaily(ctx context.Context, symbol string, opts TimeSeriesOptions) (TimeSeries, error) {
+	return c.timeSeries(ctx, "TimeSeriesDaily", "TIME_SERIES_DAILY", opts.params(symbol))
+}
+
+// TimeSeriesDailyAdjusted makes API request and returns parsed response
+func (c *Client) TimeSeriesDailyAdjusted(ctx context.Context, symbol string, opts TimeSeriesOptions) (AdjustedTimeSeries, error) {
+	return c.adjustedTimeSeries(ctx, "TimeSeriesDailyAdjusted", "TIME_SERIES_DAILY_ADJUSTED", opts.params(symbol))
+}
+
+// TimeSeriesWeekly makes API request and returns parsed response
+func (c *Client) TimeSeriesWeekly(ctx context.Context, symbol string, opts TimeSeriesOptions) (TimeSeries, error) {
+	return c.timeSeries(ctx, "TimeSeriesWeekly", "TIME_SERIES_WEEKLY", opts.params(symbol))
+}
+
+// TimeSeriesWeeklyAdjusted makes API request and returns parsed response
+func (c *Client) TimeSeriesWeeklyAdjusted(ctx context.Context, symbol string, opts TimeSeriesOptions) (AdjustedTimeSeries, error) {
+	return c.adjustedTimeSeries(ctx, "TimeSeriesWeeklyAdjusted", "TIME_SERIES_WEEKLY_ADJUSTED", opts.params(symbol))
+}
+
+// TimeSeriesMonthly makes API request and returns parsed response
+func (c *Client) TimeSeriesMonthly(ctx context.Context, symbol string, opts TimeSeriesOptions) (TimeSeries, error) {
+	return c.timeSeries(ctx, "TimeSeriesMonthly", "TIME_SERIES_MONTHLY", opts.params(symbol))
+}
+
+// TimeSeriesMonthlyAdjusted makes API request and returns parsed response
+func (c *Client) TimeSeriesMonthlyAdjusted(ctx context.Context, symbol string, opts TimeSeriesOptions) (AdjustedTimeSeries, error) {
+	return c.adjustedTimeSeries(ctx, "TimeSeriesMonthlyAdjusted", "TIME_SERIES_MONTHLY_ADJUSTED", opts.params(symbol))
+}
+
+func (c *Client) timeSeries(ctx context.Context, name string, function string, params url.Values) (TimeSeries, error) {
+	if isCSV(params) {
+		res := TimeSeries{Metadata: TimeSeriesMetadata{Symbol: params.Get("symbol")}}
+		var parseErrs ParseErrors
+		err := c.requestCSV(ctx, function, params, func(row map[string]string) error {
+			row = normalizeTimeSeriesRow(row)
+			b, err := fromBar(row["timestamp"], row, c.collectParseErrors)
+			if err != nil {
+				if parseErrs.collect(err) {
+					return nil
+				}
+				return errors.Wrapf(err, "%s parsing error", name)
+			}
+			res.Bars = append(res.Bars, b)
+			return nil
+		})
+		if err != nil {
+			return TimeSeries{}, errors.Wrapf(err, "%s error", name)
+		}
+		if len(parseErrs) > 0 {
+			return TimeSeries{}, errors.Wrapf(parseErrs, "%s parsing error", name)
+		}
+		sortBars(res.Bars)
+		return res, nil
+	}
+
+	response := rawTimeSeriesResponse{}
+	if err := c.request(ctx, function, params, &response); err != nil {
+		return TimeSeries{}, errors.Wrapf(err, "%s error", name)
+	}
+	res, err := fromTimeSeriesResponse(response, c.collectParseErrors)
+	if err != nil {
+		return TimeSeries{}, errors.Wrapf(err, "%s parsing error", name)
+	}
+	return res, nil
+}
+
+func (c *Client) adjustedTimeSeries(ctx context.Context, name string, function string, params url.Values) (AdjustedTimeSeries, error) {
+	if isCSV(params) {
+		res := AdjustedTimeSeries{Metadata: TimeSeriesMetadata{Symbol: params.Get("symbol")}}
+		var parseErrs ParseErrors
+		err := c.requestCSV(ctx, function, params, func(row map[string]string) error {
+			row = normalizeTimeSeriesRow(row)
+			b, err := fromAdjustedBar(row["timestamp"], row, c.collectParseErrors)
+			if err != nil {
+				if parseErrs.collect(err) {
+					return nil
+				}
+				return errors.Wrapf(err, "%s parsing error", name)
+			}
+			res.Bars = append(res.Bars, b)
+			return nil
+		})
+		if err != nil {
+			return AdjustedTimeSeries{}, errors.Wrapf(err, "%s error", name)
+		}
+		if len(parseErrs) > 0 {
+			return AdjustedTimeSeries{}, errors.Wrapf(parseErrs, "%s parsing error", name)
+		}
+		sortAdjustedBars(res.Bars)
+		return res, nil
+	}
+
+	response := rawTimeSeriesResponse{}
+	if err := c.request(ctx, function, params, &response); err != nil {
+		return AdjustedTimeSeries{}, errors.Wrapf(err, "%s error", name)
+	}
+	res, err := fromAdjustedTimeSeriesResponse(response, c.collectParseErrors)
+	if err != nil {
+		return AdjustedTimeSeries{}, errors.Wrapf(err, "%s parsing error", name)
+	}
+	return res, nil
+}
+
+func fromTimeSeriesResponse(response rawTimeSeriesResponse, collectAll bool) (TimeSeries, error) {
+	res := TimeSeries{
+		Metadata: response.metadata(),
+		Bars:     make([]Bar, 0, len(response.Series)),
+	}
+	var parseErrs ParseErrors
+	for date, fields := range response.Series {
+		b, err := fromBar(date, fields, collectAll)
+		if err != nil {
+			if parseErrs.collect(err) {
+				continue
+			}
+			return TimeSeries{}, err
+		}
+		res.Bars = append(res.Bars, b)
+	}
+	if len(parseErrs) > 0 {
+		return TimeSeries{}, parseErrs
+	}
+	sortBars(res.Bars)
+	return res, nil
+}
+
+func fromAdjustedTimeSeriesResponse(response rawTimeSeriesResponse, collectAll bool) (AdjustedTimeSeries, error) {
+	res := AdjustedTimeSeries{
+		Metadata: response.metadata(),
+		Bars:     make([]AdjustedBar, 0, len(response.Series)),
+	}
+	var parseErrs ParseErrors
+	for date, fields := range response.Series {
+		b, err := fromAdjustedBar(date, fields, collectAll)
+		if err != nil {
+			if parseErrs.collect(err) {
+				continue
+			}
+			return AdjustedTimeSeries{}, err
+		}
+		res.Bars = append(res.Bars, b)
+	}
+	if len(parseErrs) > 0 {
+		return AdjustedTimeSeries{}, parseErrs
+	}
+	sortAdjustedBars(res.Bars)
+	return res, nil
+}
+
+func sortBars(bars []Bar) {
+	sort.Slice(bars, func(i, j int) bool {
+		return time.Time(bars[i].Date).Before(time.Time(bars[j].Date))
+	})
+}
+
+func sortAdjustedBars(bars []AdjustedBar) {
+	sort.Slice(bars, func(i, j int) bool {
+		return time.Time(bars[i].Date).Before(time.Time(bars[j].Date))
+	})
+}
+
+// normalizeTimeSeriesRow converts CSV column names like "adjusted_close" to JSON field names like "adjusted close"
+func normalizeTimeSeriesRow(row map[string]string) map[string]string {
+	res := make(map[string]string, len(row))
+	for k, v := range row {
+		res[strings.ReplaceAll(k, "_", " ")] = v
+	}
+	return res
+}
+
+// keyIndexRegexp matches "1. " prefixes of alphavantage keys
+var keyIndexRegexp = regexp.MustCompile(`^[0-9]+[a-z]?\. `)
+
+// stripKeyIndex converts "1. open" to "open"
+func stripKeyIndex(key string) string {
+	return keyIndexRegexp.ReplaceAllString(key, "")
+}
+
+// rawTimeSeriesResponse time series response with the numbered key prefixes stripped
+type rawTimeSeriesResponse struct {
+	Metadata map[string]string
+	// Series bar fields by date
+	Series map[string]map[string]string
+}
+
+// UnmarshalJSON finds "Meta Data" and "... Time Series ..." blocks of the response
+func (r *rawTimeSeriesResponse) UnmarshalJSON(b []byte) error {
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+	for key, value := range raw {
+		switch {
+		case key == "Meta Data":
+			var metadata map[string]string
+			if err := json.Unmarshal(value, &metadata); err != nil {
+				return errors.Wrap(err, "Cannot parse Meta Data")
+			}
+			r.Metadata = make(map[string]string, len(metadata))
+			for k, v := range metadata {
+				r.Metadata[stripKeyIndex(k)] = v
+			}
+		case strings.Contains(key, "Time Series"):
+			var series map[string]map[string]string
+			if err := json.Unmarshal(value, &series); err != nil {
+				return errors.Wrapf(err, "Cannot parse %s", key)
+			}
+			r.Series = make(map[string]map[string]string, len(series))
+			for date, fields := range series {
+				stripped := make(map[string]string, len(fields))
+				for k, v := range fields {
+					stripped[stripKeyIndex(k)] = v
+				}
+				r.Series[date] = stripped
+			}
+		}
+	}
+	if r.Series == nil {
+		return errors.New("Time series is missing in the response")
+	}
+	return nil
+}
+
+func (r rawTimeSeriesResponse) metadata() TimeSeriesMetadata {
+	return TimeSeriesMetadata{
+		Information:   r.Metadata["Information"],
+		Symbol:        r.Metadata["Symbol"],
+		LastRefreshed: r.Metadata["Last Refreshed"],
+		Interval:      r.Metadata["Interval"],
+		OutputSize:    r.Metadata["Output Size"],
+		TimeZone:      r.Metadata["Time Zone"],
+	}
+}
+
+func fromBar(date string, fields map[string]string, collectAll bool) (Bar, error) {
+	p := newFieldParser("Bar", date, collectAll)
+	res := parseBar(p, date, fields)
+	return res, p.err()
+}
+
+func fromAdjustedBar(date string, fields map[string]string, collectAll bool) (AdjustedBar, error) {
+	p := newFieldParser("AdjustedBar", date, collectAll)
+	res := AdjustedBar{
+		Bar:              parseBar(p, date, fields),
+		AdjustedClose:    p.decimal("adjusted close", fields["adjusted close"]),
+		DividendAmount:   p.decimal("dividend amount", fields["dividend amount"]),
+		SplitCoefficient: p.nullDecimal("split coefficient", fields["split coefficient"]),
+	}
+	return res, p.err()
+}
+
+func parseBar(p *fieldParser, date string, fields map[string]string) Bar {
+	return Bar{
+		Date:   p.date("date", date),
+		Open:   p.decimal("open", fields["open"]),
+		High:   p.decimal("high", fields["high"]),
+		Low:    p.decimal("low", fields["low"]),
+		Close:  p.decimal("close", fields["close"]),
+		Volume: p.int64("volume", fields["volume"]),
+	}
+}
@@ -0,0 +1,303 @@
+package alphavantage
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testDailyTimeSeries = []byte(`
+{
+	"Meta Data": {
+		"1. Information": "Daily Prices (open, high, low, close) and Volumes",
+		"2. Symbol": "IBM",
+		"3. Last Refreshed": "2020-08-14",
+		"4. Output Size": "Compact",
+		"5. Time Zone": "US/Eastern"
+	},
+	"Time Series (Daily)": {
+		"2020-08-14": {
+			"1. open": "124.2000",
+			"2. high": "125.5600",
+			"3. low": "123.9100",
+			"4. close": "125.2700",
+			"5. volume": "2963753"
+		},
+		"2020-08-12": {
+			"1. open": "127.0000",
+			"2. high": "127.7500",
+			"3. low": "124.8200",
+			"4. close": "125.4500",
+			"5. volume": "3527880"
+		},
+		"2020-08-13": {
+			"1. open": "125.9600",
+			"2. high": "126.3900",
+			"3. low": "124.7700",
+			"4. close": "125.0300",
+			"5. volume": "3171258"
+		}
+	}
+}`)
+
+var testWeeklyAdjustedTimeSeries = []byte(`
+{
+	"Meta Data": {
+		"1. Information": "Weekly Adjusted Prices and Volumes",
+		"2. Symbol": "IBM",
+		"3. Last Refreshed": "2020-08-14",
+		"4. Time Zone": "US/Eastern"
+	},
+	"Weekly Adjusted Time Series": {
+		"2020-08-14": {
+			"1. open": "125.4200",
+			"2. high": "130.4700",
+			"3. low": "123.9100",
+			"4. close": "125.2700",
+			"5. adjusted close": "125.2700",
+			"6. volume": "21439373",
+			"7. dividend amount": "0.0000"
+		},
+		"2020-08-07": {
+			"1. open": "126.0000",
+			"2. high": "128.2300",
+			"3. low": "123.3400",
+			"4. close": "124.9600",
+			"5. adjusted close": "124.9600",
+			"6. volume": "20535466",
+			"7. dividend amount": "1.6300"
+		}
+	}
+}`)
+
+func TestTimeSeriesDaily(t *testing.T) {
+	httpClient := &fakeHTTPClient{StatusCode: http.StatusOK, Result: testDailyTimeSeries}
+	client := NewClient(WithHTTPClient(httpClient), WithAPIKey("demo"))
+
+	res, err := client.TimeSeriesDaily(context.TODO(), "IBM", TimeSeriesOptions{OutputSize: OutputSizeFull})
+	require.NoError(t, err)
+	assert.Equal(t, "https://www.alphavantage.co/query?function=TIME_SERIES_DAILY&outputsize=full&symbol=IBM&apikey=demo", httpClient.Request.URL.String())
+
+	assert.Equal(t, TimeSeriesMetadata{
+		Information:   "Daily Prices (open, high, low, close) and Volumes",
+		Symbol:        "IBM",
+		LastRefreshed: "2020-08-14",
+		OutputSize:    "Compact",
+		TimeZone:      "US/Eastern",
+	}, res.Metadata)
+
+	require.Len(t, res.Bars, 3)
+	assert.Equal(t, "2020-08-12", res.Bars[0].Date.String())
+	assert.Equal(t, "2020-08-13", res.Bars[1].Date.String())
+	assert.Equal(t, "2020-08-14", res.Bars[2].Date.String())
+	assert.Equal(t, "124.2000", res.Bars[2].Open.String())
+	assert.Equal(t, "125.5600", res.Bars[2].High.String())
+	assert.Equal(t, "123.9100", res.Bars[2].Low.String())
+	assert.Equal(t, "125.2700", res.Bars[2].Close.String())
+	assert.Equal(t, int64(2963753), res.Bars[2].Volume)
+}
+
+func TestTimeSeriesWeeklyAdjusted(t *testing.T) {
+	httpClient := &fakeHTTPClient{StatusCode: http.StatusOK, Result: testWeeklyAdjustedTimeSeries}
+	client := NewClient(WithHTTPClient(httpClient), WithAPIKey("demo"))
+
+	res, err := client.TimeSeriesWeeklyAdjusted(context.TODO(), "IBM", TimeSeriesOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "https://www.alphavantage.co/query?function=TIME_SERIES_WEEKLY_ADJUSTED&symbol=IBM&apikey=demo", httpClient.Request.URL.String())
+	assert.Equal(t, "US/Eastern", res.Metadata.TimeZone)
+	assert.Equal(t, "", res.Metadata.OutputSize)
+
+	require.Len(t, res.Bars, 2)
+	assert.Equal(t, "2020-08-07", res.Bars[0].Date.String())
+	assert.Equal(t, "124.9600", res.Bars[0].AdjustedClose.String())
+	assert.Equal(t, "1.6300", res.Bars[0].DividendAmount.String())
+	assert.Equal(t, int64(20535466), res.Bars[0].Volume)
+	assert.False(t, res.Bars[0].SplitCoefficient.Valid)
+	assert.Equal(t, "2020-08-14", res.Bars[1].Date.String())
+}
+
+func TestTimeSeriesParseError(t *testing.T) {
+	httpClient := &fakeHTTPClient{StatusCode: http.StatusOK, Result: []byte(`
+	{
+		"Meta Data": {"2. Symbol": "IBM"},
+		"Monthly Time Series": {
+			"2020-08-14": {"1. open": "125.42", "2. high": "130.47", "3. low": "n/a", "4. close": "125.27", "5. volume": "21439373"}
+		}
+	}`)}
+	client := NewClient(WithHTTPClient(httpClient))
+
+	_, err := client.TimeSeriesMonthly(context.TODO(), "IBM", TimeSeriesOptions{})
+	require.Error(t, err)
+	var parseErr *ParseError
+	require.True(t, errors.As(err, &parseErr))
+	assert.Equal(t, "Bar", parseErr.Statement)
+	assert.Equal(t, "2020-08-14", parseErr.Record)
+	assert.Equal(t, "low", parseErr.Field)
+}
+
+func TestTimeSeriesMissing(t *testing.T) {
+	httpClient := &fakeHTTPClient{StatusCode: http.StatusOK, Result: []byte(`{"Meta Data": {"2. Symbol": "IBM"}}`)}
+	client := NewClient(WithHTTPClient(httpClient))
+
+	_, err := client.TimeSeriesDaily(context.TODO(), "IBM", TimeSeriesOptions{})
+	require.Error(t, err)
+}
+
+func TestStripKeyIndex(t *testing.T) {
+	testCases := map[string]string{
+		"1. open":           "open",
+		"5. adjusted close": "adjusted close",
+		"10. volume":        "volume",
+		"1a. open (USD)":    "open (USD)",
+		"open":              "open",
+	}
+
+	for input, expectedResult := range testCases {
+		assert.Equal(t, expectedResult, stripKeyIndex(input), input)
+	}
+}
+
+var testDailyTimeSeriesCSV = []byte("timestamp,open,high,low,close,volume\r\n" +
+	"2020-08-14,124.2000,125.5600,123.9100,125.2700,2963753\r\n" +
+	"2020-08-13,125.9600,126.3900,124.7700,125.0300,3171258\r\n" +
+	"2020-08-12,127.0000,127.7500,124.8200,125.4500,3527880\r\n")
+
+var testWeeklyAdjustedTimeSeriesCSV = []byte("timestamp,open,high,low,close,adjusted close,volume,dividend amount\r\n" +
+	"2020-08-14,125.4200,130.4700,123.9100,125.2700,125.2700,21439373,0.0000\r\n" +
+	"2020-08-07,126.0000,128.2300,123.3400,124.9600,124.9600,20535466,1.6300\r\n")
+
+var testDailyAdjustedTimeSeries = []byte(`
+{
+	"Meta Data": {
+		"1. Information": "Daily Time Series with Splits and Dividend Events",
+		"2. Symbol": "AAPL",
+		"3. Last Refreshed": "2020-08-31",
+		"4. Output Size": "Compact",
+		"5. Time Zone": "US/Eastern"
+	},
+	"Time Series (Daily)": {
+		"2020-08-31": {
+			"1. open": "127.5800",
+			"2. high": "131.0000",
+			"3. low": "126.0000",
+			"4. close": "129.0400",
+			"5. adjusted close": "128.4367",
+			"6. volume": "225702700",
+			"7. dividend amount": "0.0000",
+			"8. split coefficient": "4.0"
+		},
+		"2020-08-28": {
+			"1. open": "504.0500",
+			"2. high": "505.7700",
+			"3. low": "498.3100",
+			"4. close": "499.2300",
+			"5. adjusted close": "124.2236",
+			"6. volume": "46907479",
+			"7. dividend amount": "0.0000",
+			"8. split coefficient": "1.0"
+		}
+	}
+}`)
+
+var testDailyAdjustedTimeSeriesCSV = []byte("timestamp,open,high,low,close,adjusted_close,volume,dividend_amount,split_coefficient\r\n" +
+	"2020-08-31,127.5800,131.0000,126.0000,129.0400,128.4367,225702700,0.0000,4.0\r\n" +
+	"2020-08-28,504.0500,505.7700,498.3100,499.2300,124.2236,46907479,0.0000,1.0\r\n")
+
+func TestTimeSeriesCSVMatchesJSON(t *testing.T) {
+	jsonClient := NewClient(WithHTTPClient(&fakeHTTPClient{StatusCode: http.StatusOK, Result: testDailyTimeSeries}))
+	csvHTTPClient := &fakeHTTPClient{StatusCode: http.StatusOK, Result: testDailyTimeSeriesCSV}
+	csvClient := NewClient(WithHTTPClient(csvHTTPClient), WithAPIKey("demo"))
+
+	fromJSON, err := jsonClient.TimeSeriesDaily(context.TODO(), "IBM", TimeSeriesOptions{})
+	require.NoError(t, err)
+	fromCSV, err := csvClient.TimeSeriesDaily(context.TODO(), "IBM", TimeSeriesOptions{OutputSize: OutputSizeFull, DataType: DataTypeCSV})
+	require.NoError(t, err)
+	assert.Equal(t, "https://www.alphavantage.co/query?function=TIME_SERIES_DAILY&datatype=csv&outputsize=full&symbol=IBM&apikey=demo", csvHTTPClient.Request.URL.String())
+	assert.Equal(t, "IBM", fromCSV.Metadata.Symbol)
+	require.Len(t, fromCSV.Bars, 3)
+	assert.Equal(t, fromJSON.Bars, fromCSV.Bars)
+}
+
+func TestAdjustedTimeSeriesCSVMatchesJSON(t *testing.T) {
+	testCases := []struct {
+		json []byte
+		csv  []byte
+		call func(*Client, TimeSeriesOptions) (AdjustedTimeSeries, error)
+	}{
+		{
+			json: testDailyAdjustedTimeSeries,
+			csv:  testDailyAdjustedTimeSeriesCSV,
+			call: func(c *Client, opts TimeSeriesOptions) (AdjustedTimeSeries, error) {
+				return c.TimeSeriesDailyAdjusted(context.TODO(), "AAPL", opts)
+			},
+		},
+		{
+			json: testWeeklyAdjustedTimeSeries,
+			csv:  testWeeklyAdjustedTimeSeriesCSV,
+			call: func(c *Client, opts TimeSeriesOptions) (AdjustedTimeSeries, error) {
+				return c.TimeSeriesWeeklyAdjusted(context.TODO(), "IBM", opts)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		fromJSON, err := testCase.call(NewClient(WithHTTPClient(&fakeHTTPClient{StatusCode: http.StatusOK, Result: testCase.json})), TimeSeriesOptions{})
+		require.NoError(t, err)
+		fromCSV, err := testCase.call(NewClient(WithHTTPClient(&fakeHTTPClient{StatusCode: http.StatusOK, Result: testCase.csv})), TimeSeriesOptions{DataType: DataTypeCSV})
+		require.NoError(t, err)
+		require.Len(t, fromCSV.Bars, 2)
+		assert.Equal(t, fromJSON.Bars, fromCSV.Bars)
+	}
+}
+
+func TestTimeSeriesDailyAdjustedSplit(t *testing.T) {
+	client := NewClient(WithHTTPClient(&fakeHTTPClient{StatusCode: http.StatusOK, Result: testDailyAdjustedTimeSeries}))
+
+	res, err := client.TimeSeriesDailyAdjusted(context.TODO(), "AAPL", TimeSeriesOptions{})
+	require.NoError(t, err)
+	require.Len(t, res.Bars, 2)
+	assert.Equal(t, "2020-08-28", res.Bars[0].Date.String())
+	assert.Equal(t, "4.0", res.Bars[1].SplitCoefficient.String())
+	assert.Equal(t, "128.4367", res.Bars[1].AdjustedClose.String())
+}
+
+func TestTimeSeriesCSVParseError(t *testing.T) {
+	client := NewClient(WithHTTPClient(&fakeHTTPClient{StatusCode: http.StatusOK, Result: []byte("timestamp,open,high,low,close,volume\r\n" +
+		"2020-08-14,124.2000,125.5600,123.9100,125.2700,2.9e6\r\n")}))
+
+	_, err := client.TimeSeriesMonthly(context.TODO(), "IBM", TimeSeriesOptions{DataType: DataTypeCSV})
+	require.Error(t, err)
+	var parseErr *ParseError
+	require.True(t, errors.As(err, &parseErr))
+	assert.Equal(t, "volume", parseErr.Field)
+	assert.Equal(t, "2020-08-14", parseErr.Record)
+}
+
+func TestTimeSeriesParseErrorCollection(t *testing.T) {
+	csvResult := []byte("timestamp,open,high,low,close,volume\r\n" +
+		"2020-08-14,124.2000,125.5600,123.9100,125.2700,2.9e6\r\n" +
+		"2020-08-13,125.9600,126.3900,124.7700,125.0300,3171258\r\n" +
+		"2020-08-12,127.0000,n/a,124.8200,125.4500,3527880\r\n")
+	jsonResult := []byte(`{"Meta Data": {}, "Time Series (Monthly)": {
+		"2020-08-14": {"1. open": "124.2", "2. high": "125.5", "3. low": "123.9", "4. close": "125.2", "5. volume": "2.9e6"},
+		"2020-08-13": {"1. open": "125.9", "2. high": "126.3", "3. low": "124.7", "4. close": "125.0", "5. volume": "3171258"},
+		"2020-08-12": {"1. open": "127.0", "2. high": "n/a", "3. low": "124.8", "4. close": "125.4", "5. volume": "3527880"}
+	}}`)
+
+	for dataType, result := range map[DataType][]byte{DataTypeCSV: csvResult, DataTypeJSON: jsonResult} {
+		client := NewClient(WithHTTPClient(&fakeHTTPClient{StatusCode: http.StatusOK, Result: result}), WithParseErrorCollection())
+		_, err := client.TimeSeriesMonthly(context.TODO(), "IBM", TimeSeriesOptions{DataType: dataType})
+		require.Error(t, err, dataType)
+		var parseErrs ParseErrors
+		require.True(t, errors.As(err, &parseErrs), dataType)
+		fields := map[string]string{}
+		for _, parseErr := range parseErrs {
+			fields[parseErr.Record] = parseErr.Field
+		}
+		assert.Equal(t, map[string]string{"2020-08-14": "volume", "2020-08-12": "high"}, fields, dataType)
+	}
+}
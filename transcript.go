@@ -0,0 +1,113 @@
+package alphavantage
+
+import (
+	"context"
+	"encoding/json"
+	"regexp"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var quarterPattern = regexp.MustCompile(`^[0-9]{4}Q[1-4]$`)
+
+// SpeakerRole role of an earnings call participant
+type SpeakerRole string
+
+// Earnings call participant roles
+const (
+	RoleExecutive SpeakerRole = "executive"
+	RoleAnalyst   SpeakerRole = "analyst"
+	RoleOperator  SpeakerRole = "operator"
+)
+
+// EarningsCallTranscript earnings call of a company in a fiscal quarter
+type EarningsCallTranscript struct {
+	Symbol string `json:"symbol"`
+	// Quarter is a fiscal quarter, e.g. "2024Q1"
+	Quarter  string              `json:"quarter"`
+	Segments []TranscriptSegment `json:"segments"`
+}
+
+// TranscriptSegment uninterrupted statement of a single speaker
+type TranscriptSegment struct {
+	Speaker string `json:"speaker"`
+	Title   string `json:"title"`
+	Content string `json:"content"`
+	// Sentiment ranges from -1 (bearish) to 1 (bullish)
+	Sentiment float64 `json:"sentiment"`
+}
+
+// Role guesses the speaker role from the title: operators and analysts are named so, everyone else is an executive
+func (s TranscriptSegment) Role() SpeakerRole {
+	title := strings.ToLower(s.Title)
+	switch {
+	case strings.Contains(title, "operator") || strings.EqualFold(s.Speaker, "Operator"):
+		return RoleOperator
+	case strings.Contains(title, "analyst"):
+		return RoleAnalyst
+	default:
+		return RoleExecutive
+	}
+}
+
+// Text concatenates contents of segments spoken by role, one paragraph per segment
+func (t EarningsCallTranscript) Text(role SpeakerRole) string {
+	paragraphs := make([]string, 0, len(t.Segments))
+	for _, segment := range t.Segments {
+		if segment.Role() == role {
+			paragraphs = append(paragraphs, segment.Content)
+		}
+	}
+	return strings.Join(paragraphs, "\n\n")
+}
+
+// EarningsCallTranscript makes API request and returns parsed response, quarter is formatted as "2024Q1"
+func (c *Client) EarningsCallTranscript(ctx context.Context, symbol string, quarter string) (EarningsCallTranscript, error) {
+	if !quarterPattern.MatchString(quarter) {
+		return EarningsCallTranscript{}, errors.Errorf("EarningsCallTranscript error: quarter '%s' does not match YYYYQn", quarter)
+	}
+	params := symbolParams(symbol)
+	params.Set("quarter", quarter)
+	response := rawEarningsCallTranscriptResponse{}
+	if err := c.request(ctx, "EARNINGS_CALL_TRANSCRIPT", params, &response); err != nil {
+		return EarningsCallTranscript{}, errors.Wrap(err, "EarningsCallTranscript error")
+	}
+	res, err := fromEarningsCallTranscript(response, c.collectParseErrors)
+	if err != nil {
+		return EarningsCallTranscript{}, errors.Wrap(err, "EarningsCallTranscript parsing error")
+	}
+	return res, nil
+}
+
+type rawEarningsCallTranscriptResponse struct {
+	Symbol     string                 `json:"symbol"`
+	Quarter    string                 `json:"quarter"`
+	Transcript []rawTranscriptSegment `json:"transcript"`
+}
+
+type rawTranscriptSegment struct {
+	Speaker   string          `json:"speaker"`
+	Title     string          `json:"title"`
+	Content   string          `json:"content"`
+	Sentiment json.RawMessage `json:"sentiment"`
+}
+
+func fromEarningsCallTranscript(raw rawEarningsCallTranscriptResponse, collectAll bool) (EarningsCallTranscript, error) {
+	p := newFieldParser("EarningsCallTranscript", raw.Quarter, collectAll)
+	res := EarningsCallTranscript{
+		Symbol:   raw.Symbol,
+		Quarter:  raw.Quarter,
+		Segments: make([]TranscriptSegment, 0, len(raw.Transcript)),
+	}
+	for _, segment := range raw.Transcript {
+		res.Segments = append(res.Segments, TranscriptSegment{
+			Speaker: segment.Speaker,
+			Title:   segment.Title,
+			Content: segment.Content,
+			// sentiment is quoted, but be lenient about unquoted one
+			Sentiment: p.float64("sentiment", strings.Trim(string(segment.Sentiment), "\"")),
+		})
+	}
+	return res, p.err()
+}
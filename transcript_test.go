@@ -0,0 +1,100 @@
+package alphavantage
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testEarningsCallTranscript = []byte(`
+{
+	"symbol": "IBM",
+	"quarter": "2024Q1",
+	"transcript": [
+		{
+			"speaker": "Operator",
+			"title": "Operator",
+			"content": "Welcome and thank you for standing by.",
+			"sentiment": "0.5"
+		},
+		{
+			"speaker": "Arvind Krishna",
+			"title": "Chairman and Chief Executive Officer",
+			"content": "We had a solid start to the year.",
+			"sentiment": "0.7"
+		},
+		{
+			"speaker": "Toni Sacconaghi",
+			"title": "Bernstein - Analyst",
+			"content": "How should we think about software growth?",
+			"sentiment": "0.1"
+		},
+		{
+			"speaker": "James Kavanaugh",
+			"title": "Senior Vice President and Chief Financial Officer",
+			"content": "Software grew 5% at constant currency.",
+			"sentiment": -0.2
+		}
+	]
+}`)
+
+func TestEarningsCallTranscript(t *testing.T) {
+	httpClient := &fakeHTTPClient{StatusCode: http.StatusOK, Result: testEarningsCallTranscript}
+	client := NewClient(WithHTTPClient(httpClient), WithAPIKey("demo"))
+
+	res, err := client.EarningsCallTranscript(context.TODO(), "IBM", "2024Q1")
+	require.NoError(t, err)
+	assert.Equal(t, "https://www.alphavantage.co/query?function=EARNINGS_CALL_TRANSCRIPT&quarter=2024Q1&symbol=IBM&apikey=demo", httpClient.Request.URL.String())
+
+	assert.Equal(t, "IBM", res.Symbol)
+	assert.Equal(t, "2024Q1", res.Quarter)
+	require.Len(t, res.Segments, 4)
+	assert.Equal(t, TranscriptSegment{
+		Speaker:   "Arvind Krishna",
+		Title:     "Chairman and Chief Executive Officer",
+		Content:   "We had a solid start to the year.",
+		Sentiment: 0.7,
+	}, res.Segments[1])
+	assert.Equal(t, -0.2, res.Segments[3].Sentiment)
+}
+
+func TestEarningsCallTranscriptInvalidQuarter(t *testing.T) {
+	for _, quarter := range []string{"", "2024", "2024Q5", "2024q1", "24Q1", "Q1 2024"} {
+		httpClient := &fakeHTTPClient{StatusCode: http.StatusOK, Result: testEarningsCallTranscript}
+		client := NewClient(WithHTTPClient(httpClient))
+
+		_, err := client.EarningsCallTranscript(context.TODO(), "IBM", quarter)
+		assert.Error(t, err, quarter)
+		assert.Nil(t, httpClient.Request, quarter)
+	}
+}
+
+func TestEarningsCallTranscriptParseError(t *testing.T) {
+	httpClient := &fakeHTTPClient{StatusCode: http.StatusOK, Result: []byte(`{"symbol": "IBM", "quarter": "2024Q1", "transcript": [{"speaker": "Operator", "title": "Operator", "content": "Welcome.", "sentiment": "positive"}]}`)}
+	client := NewClient(WithHTTPClient(httpClient))
+
+	_, err := client.EarningsCallTranscript(context.TODO(), "IBM", "2024Q1")
+	require.Error(t, err)
+	var parseErr *ParseError
+	require.True(t, errors.As(err, &parseErr))
+	assert.Equal(t, "EarningsCallTranscript", parseErr.Statement)
+	assert.Equal(t, "2024Q1", parseErr.Record)
+	assert.Equal(t, "sentiment", parseErr.Field)
+}
+
+func TestEarningsCallTranscriptText(t *testing.T) {
+	client := NewClient(WithHTTPClient(&fakeHTTPClient{StatusCode: http.StatusOK, Result: testEarningsCallTranscript}))
+	res, err := client.EarningsCallTranscript(context.TODO(), "IBM", "2024Q1")
+	require.NoError(t, err)
+
+	assert.Equal(t, RoleOperator, res.Segments[0].Role())
+	assert.Equal(t, RoleExecutive, res.Segments[1].Role())
+	assert.Equal(t, RoleAnalyst, res.Segments[2].Role())
+	assert.Equal(t, "We had a solid start to the year.\n\nSoftware grew 5% at constant currency.", res.Text(RoleExecutive))
+	assert.Equal(t, "How should we think about software growth?", res.Text(RoleAnalyst))
+	assert.Equal(t, "", EarningsCallTranscript{}.Text(RoleAnalyst))
+}